@@ -0,0 +1,10 @@
+// Package api holds the specifications of the public APIs of the service, they are served by the binary and checked
+// against the handlers by contract tests.
+package api
+
+import _ "embed"
+
+// OpenAPI is the OpenAPI 3 document of the versioned HTTP API.
+//
+//go:embed openapi.json
+var OpenAPI []byte
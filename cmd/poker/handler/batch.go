@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"runtime"
+
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/batch"
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/metrics"
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+	"github.com/devandreyl/go-poker-hands-evaluator/pkg/holdem"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+)
+
+const (
+	ndjsonContentType = "application/x-ndjson"
+	// maxBatchLineSize is the longest deal line, longer lines stop the stream with a CodeApiLineTooLong error.
+	maxBatchLineSize = 1 << 20
+	// batchWindowPerWorker bounds deals that are read but not written yet. When the client doesn't read results,
+	// the handler stops reading deals, so the client can't make the server buffer the whole stream.
+	batchWindowPerWorker = 2
+)
+
+type batchDealRequest struct {
+	// ID is any JSON value that is returned with the result of the deal.
+	ID    json.RawMessage `json:"id,omitempty"`
+	Game  holdem.Game     `json:"game"`
+	Board []string        `json:"board" validate:"max=5"`
+	Hands holdem.Hands    `json:"hands" validate:"required_without=Seats,excluded_with=Seats,dive,min=1,max=7"`
+	Seats holdem.Seats    `json:"seats" validate:"required_without=Hands"`
+}
+
+type batchDealResponse struct {
+	// Line is the number of the deal line in the request, starting from one.
+	Line   int                `json:"line"`
+	ID     json.RawMessage    `json:"id,omitempty"`
+	Result *holdem.GameResult `json:"result,omitempty"`
+	Error  *pokererr.Error    `json:"error,omitempty"`
+}
+
+// batchLine is one line of a batch request, readErr is set when the line couldn't be read.
+type batchLine struct {
+	number  int
+	data    []byte
+	readErr *pokererr.Error
+}
+
+type BatchHandler struct {
+	router   *mux.Router
+	validate *validator.Validate
+	workers  int
+}
+
+// NewBatchHandler creates the handler of batch requests, every request is evaluated by the given number of workers,
+// zero means the number of CPUs.
+func NewBatchHandler(router *mux.Router, validate *validator.Validate, workers int) BatchHandler {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	return BatchHandler{
+		router:   router,
+		validate: validate,
+		workers:  workers,
+	}
+}
+
+func (h *BatchHandler) Register() {
+	h.router.HandleFunc("/evaluate-batch", h.evaluateBatch).
+		Methods(http.MethodPost, http.MethodOptions)
+}
+
+// evaluateBatch reads deals as newline-delimited JSON and streams a result line for every deal in the same order.
+// Deals are evaluated by a bounded pool of workers while the next ones are read, errors are reported per line.
+func (h *BatchHandler) evaluateBatch(w http.ResponseWriter, r *http.Request) {
+	controller := http.NewResponseController(w)
+	// Results are written while deals are still being read.
+	_ = controller.EnableFullDuplex()
+
+	w.Header().Set("Content-Type", ndjsonContentType)
+	w.WriteHeader(http.StatusOK)
+	// Clients that wait for the response before sending deals get the headers right away.
+	_ = controller.Flush()
+
+	encoder := json.NewEncoder(w)
+	_ = batch.Process(r.Context(), h.workers, h.workers*batchWindowPerWorker, newLineReader(r.Body), h.evaluateDeal,
+		func(response batchDealResponse, pending int) error {
+			if err := encoder.Encode(response); err != nil {
+				return err
+			}
+			if pending == 0 {
+				return controller.Flush()
+			}
+			return nil
+		},
+	)
+}
+
+// newLineReader returns non-empty lines of the body one by one. A read error is returned as the last line.
+func newLineReader(body io.Reader) func() (batchLine, bool) {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxBatchLineSize)
+
+	number, done := 0, false
+	return func() (batchLine, bool) {
+		for !done {
+			number++
+			if !scanner.Scan() {
+				done = true
+				if err := scanner.Err(); err != nil {
+					return batchLine{number: number, readErr: batchReadError(err, number)}, true
+				}
+				break
+			}
+
+			if len(bytes.TrimSpace(scanner.Bytes())) > 0 {
+				return batchLine{number: number, data: append([]byte(nil), scanner.Bytes()...)}, true
+			}
+		}
+
+		return batchLine{}, false
+	}
+}
+
+func (h *BatchHandler) evaluateDeal(ctx context.Context, line batchLine) batchDealResponse {
+	response := batchDealResponse{Line: line.number}
+	if line.readErr != nil {
+		response.Error, _ = toPokerError(line.readErr)
+		return response
+	}
+
+	var req batchDealRequest
+	if err := json.Unmarshal(line.data, &req); err != nil {
+		response.Error, _ = toPokerError(pokererr.Wrap(err, pokererr.CodeApiDecoderError, nil))
+		return response
+	}
+	response.ID = req.ID
+
+	if err := h.validate.StructCtx(ctx, req); err != nil {
+		response.Error, _ = toPokerError(err)
+		return response
+	}
+
+	if req.Game == "" {
+		req.Game = holdem.DefaultGame(req.Board)
+	}
+
+	result, err := holdem.EvaluateGameSeats(req.Game, req.Board, seatsOf(req.Hands, req.Seats))
+	if err != nil {
+		response.Error, _ = toPokerError(err)
+		return response
+	}
+	metrics.ObserveGameResult(result)
+	response.Result = result
+
+	return response
+}
+
+func batchReadError(err error, line int) *pokererr.Error {
+	if errors.Is(err, bufio.ErrTooLong) {
+		return pokererr.NewError(pokererr.CodeApiLineTooLong, pokererr.Data{
+			"line": line,
+			"max":  maxBatchLineSize,
+		})
+	}
+
+	return pokererr.Wrap(err, pokererr.CodeApiDecoderError, nil)
+}
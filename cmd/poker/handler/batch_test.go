@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+)
+
+func newBatchServer(t *testing.T, workers int) *httptest.Server {
+	t.Helper()
+
+	router := mux.NewRouter()
+	batchHandler := NewBatchHandler(router, validator.New(), workers)
+	batchHandler.Register()
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func postBatch(t *testing.T, server *httptest.Server, body string) []batchDealResponse {
+	t.Helper()
+
+	resp, err := http.Post(server.URL+"/evaluate-batch", ndjsonContentType, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	defer resp.Body.Close()
+
+	if contentType := resp.Header.Get("Content-Type"); contentType != ndjsonContentType {
+		t.Errorf("Expected content type %s, but got %s", ndjsonContentType, contentType)
+	}
+
+	var responses []batchDealResponse
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		var response batchDealResponse
+		if err := json.Unmarshal(scanner.Bytes(), &response); err != nil {
+			t.Fatalf("Unexpected error %v in line %s", err, scanner.Text())
+		}
+		responses = append(responses, response)
+	}
+
+	return responses
+}
+
+func TestBatchHandler_EvaluateBatch(t *testing.T) {
+	server := newBatchServer(t, 4)
+
+	body := strings.Join([]string{
+		`{"id": 1, "hands": {"first": ["7H", "8H", "9H", "TH", "JH"], "second": ["2C", "2D", "5S", "7C", "9D"]}}`,
+		`{"id": "board", "game": "holdem", "board": ["AS", "KD", "7C"], "seats": [{"name": "b", "cards": ["AD", "AC"]}, {"name": "a", "cards": ["KC", "3C"]}]}`,
+		``,
+		`{"id": 3, "hands": {"first": ["7H", "8H", "9H", "TH", "XH"]}}`,
+		`{"id": 4, "hands":`,
+		`{"id": 5, "board": ["AS", "KD", "7C", "2D", "3D", "4D"], "hands": {"first": ["AD", "AC"]}}`,
+	}, "\n")
+
+	responses := postBatch(t, server, body)
+	if len(responses) != 5 {
+		t.Fatalf("Expected 5 results, but got %d", len(responses))
+	}
+
+	tests := []struct {
+		line          int
+		id            string
+		expectedError pokererr.Code
+	}{
+		{line: 1, id: "1"},
+		{line: 2, id: `"board"`},
+		{line: 4, id: "3", expectedError: pokererr.CodeInvalidCard},
+		{line: 5, expectedError: pokererr.CodeApiDecoderError},
+		{line: 6, id: "5", expectedError: pokererr.CodeValidationError},
+	}
+
+	for i, test := range tests {
+		response := responses[i]
+		if response.Line != test.line || string(response.ID) != test.id {
+			t.Errorf("Expected line %d with id %s, but got line %d with id %s", test.line, test.id, response.Line, response.ID)
+		}
+
+		if test.expectedError == "" {
+			if response.Error != nil || response.Result == nil {
+				t.Errorf("Expected a result in line %d, but got error %v", test.line, response.Error)
+			}
+			continue
+		}
+		if response.Error == nil || response.Error.Code != test.expectedError {
+			t.Errorf("Expected error code %s in line %d, but got %v", test.expectedError, test.line, response.Error)
+		}
+	}
+
+	if winners := responses[1].Result.Winners; len(winners) != 1 || winners[0] != "b" {
+		t.Errorf("Expected the seat b to win, but got %v", winners)
+	}
+}
+
+func TestBatchHandler_Order(t *testing.T) {
+	server := newBatchServer(t, 8)
+
+	var body strings.Builder
+	for i := 0; i < 500; i++ {
+		fmt.Fprintf(&body, `{"id": %d, "game": "holdem", "board": ["AS", "KD", "7C", "2H", "9S"], "hands": {"first": ["AH", "KC"], "second": ["7D", "7S"]}}`+"\n", i)
+	}
+
+	responses := postBatch(t, server, body.String())
+	if len(responses) != 500 {
+		t.Fatalf("Expected 500 results, but got %d", len(responses))
+	}
+	for i, response := range responses {
+		if string(response.ID) != fmt.Sprint(i) || response.Line != i+1 {
+			t.Fatalf("Expected result %d in line %d, but got id %s in line %d", i, i+1, response.ID, response.Line)
+		}
+	}
+}
+
+func TestBatchHandler_LineTooLong(t *testing.T) {
+	server := newBatchServer(t, 1)
+
+	body := `{"hands": {"first": ["7H", "8H", "9H", "TH", "JH"]}}` + "\n" + strings.Repeat(" ", maxBatchLineSize+1)
+
+	responses := postBatch(t, server, body)
+	if len(responses) != 2 {
+		t.Fatalf("Expected 2 results, but got %d", len(responses))
+	}
+	if last := responses[1]; last.Error == nil || last.Error.Code != pokererr.CodeApiLineTooLong || last.Line != 2 {
+		t.Errorf("Expected error code %s in line 2, but got %v in line %d", pokererr.CodeApiLineTooLong, last.Error, last.Line)
+	}
+}
+
+func TestBatchHandler_Streaming(t *testing.T) {
+	server := newBatchServer(t, 2)
+
+	bodyReader, bodyWriter := io.Pipe()
+	defer bodyWriter.Close()
+
+	resp, err := http.Post(server.URL+"/evaluate-batch", ndjsonContentType, bodyReader)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	defer resp.Body.Close()
+
+	results := bufio.NewScanner(resp.Body)
+	for i := 0; i < 3; i++ {
+		// Every result is read before the next deal is sent, so the results have to be streamed.
+		if _, err := fmt.Fprintf(bodyWriter, `{"id": %d, "hands": {"first": ["7H", "8H", "9H", "TH", "JH"]}}`+"\n", i); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		if !results.Scan() {
+			t.Fatalf("Expected result %d, but the stream ended with %v", i, results.Err())
+		}
+
+		var response batchDealResponse
+		if err := json.Unmarshal(results.Bytes(), &response); err != nil || string(response.ID) != fmt.Sprint(i) {
+			t.Fatalf("Expected result %d, but got %s", i, results.Text())
+		}
+	}
+}
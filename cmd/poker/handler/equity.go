@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"encoding/json"
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+	"github.com/devandreyl/go-poker-hands-evaluator/pkg/holdem"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type equityRequest struct {
+	Hands      holdem.Hands `json:"hands" validate:"required_without=Seats,excluded_with=Seats,omitempty,min=2,max=23,dive,len=2"`
+	Seats      holdem.Seats `json:"seats" validate:"required_without=Hands,omitempty,min=2,max=23"`
+	Board      []string     `json:"board" validate:"max=5"`
+	Dead       []string     `json:"dead"`
+	Iterations int          `json:"iterations" validate:"min=0,max=10000000"`
+	Seed       int64        `json:"seed"`
+}
+
+type rangeEquityRequest struct {
+	Ranges     map[string]string `json:"ranges" validate:"required,min=2,max=23,dive,required"`
+	Board      []string          `json:"board" validate:"max=5"`
+	Dead       []string          `json:"dead"`
+	Iterations int               `json:"iterations" validate:"min=0,max=10000000"`
+	Seed       int64             `json:"seed"`
+}
+
+type EquityHandler struct {
+	router   *mux.Router
+	validate *validator.Validate
+}
+
+func NewEquityHandler(router *mux.Router, validate *validator.Validate) EquityHandler {
+	return EquityHandler{
+		router:   router,
+		validate: validate,
+	}
+}
+
+func (h *EquityHandler) Register() {
+	h.router.HandleFunc("/equity", h.equity).
+		Methods(http.MethodPost, http.MethodOptions)
+	h.router.HandleFunc("/range-equity", h.rangeEquity).
+		Methods(http.MethodPost, http.MethodOptions)
+}
+
+func (h *EquityHandler) equity(w http.ResponseWriter, r *http.Request) {
+	var req equityRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJsonErr(w, r, pokererr.Wrap(err, pokererr.CodeApiDecoderError, nil))
+		return
+	}
+
+	if err := h.validate.StructCtx(r.Context(), req); err != nil {
+		writeJsonErr(w, r, err)
+		return
+	}
+
+	result, err := holdem.CalculateEquity(r.Context(), holdem.EquityRequest{
+		Hands:      req.Hands,
+		Seats:      req.Seats,
+		Board:      req.Board,
+		Dead:       req.Dead,
+		Iterations: req.Iterations,
+		Seed:       req.Seed,
+	})
+	if err != nil {
+		writeJsonErr(w, r, err)
+		return
+	}
+
+	writeJson(w, r, http.StatusOK, result)
+}
+
+func (h *EquityHandler) rangeEquity(w http.ResponseWriter, r *http.Request) {
+	var req rangeEquityRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJsonErr(w, r, pokererr.Wrap(err, pokererr.CodeApiDecoderError, nil))
+		return
+	}
+
+	if err := h.validate.StructCtx(r.Context(), req); err != nil {
+		writeJsonErr(w, r, err)
+		return
+	}
+
+	ranges, err := holdem.ParseRanges(req.Ranges)
+	if err != nil {
+		writeJsonErr(w, r, err)
+		return
+	}
+
+	result, err := holdem.CalculateRangeEquity(r.Context(), holdem.RangeEquityRequest{
+		Ranges:     ranges,
+		Board:      req.Board,
+		Dead:       req.Dead,
+		Iterations: req.Iterations,
+		Seed:       req.Seed,
+	})
+	if err != nil {
+		writeJsonErr(w, r, err)
+		return
+	}
+
+	writeJson(w, r, http.StatusOK, result)
+}
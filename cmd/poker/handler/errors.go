@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+	"github.com/gorilla/mux"
+)
+
+type errorCatalogResponse struct {
+	Errors []pokererr.CodeInfo `json:"errors"`
+}
+
+type ErrorsHandler struct {
+	router *mux.Router
+}
+
+// NewErrorsHandler creates the handler of the error catalog, the type of problem details points to its entries.
+func NewErrorsHandler(router *mux.Router) ErrorsHandler {
+	return ErrorsHandler{
+		router: router,
+	}
+}
+
+func (h *ErrorsHandler) Register() {
+	h.router.HandleFunc("/errors", h.errors).
+		Methods(http.MethodGet, http.MethodOptions)
+}
+
+func (h *ErrorsHandler) errors(w http.ResponseWriter, r *http.Request) {
+	writeJson(w, r, http.StatusOK, errorCatalogResponse{Errors: pokererr.Catalog()})
+}
@@ -2,15 +2,29 @@ package handler
 
 import (
 	"encoding/json"
-	"github.com/devandreyl/go-poker-hands-evaluator/internal/holdem"
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/metrics"
 	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+	"github.com/devandreyl/go-poker-hands-evaluator/pkg/holdem"
 	"github.com/go-playground/validator/v10"
 	"github.com/gorilla/mux"
 	"net/http"
 )
 
 type evaluateRequest struct {
-	Hands holdem.Hands `json:"hands" validate:"required"`
+	Game  holdem.Game  `json:"game"`
+	Hands holdem.Hands `json:"hands" validate:"required_without=Seats,excluded_with=Seats"`
+	Seats holdem.Seats `json:"seats" validate:"required_without=Hands"`
+}
+
+type evaluateBoardRequest struct {
+	Game  holdem.Game  `json:"game"`
+	Board []string     `json:"board" validate:"max=5"`
+	Hands holdem.Hands `json:"hands" validate:"required_without=Seats,excluded_with=Seats,dive,min=1,max=7"`
+	Seats holdem.Seats `json:"seats" validate:"required_without=Hands"`
+}
+
+type gamesResponse struct {
+	Games []holdem.Game `json:"games"`
 }
 
 type EvaluateHandHandler struct {
@@ -28,26 +42,83 @@ func NewEvaluateHandler(router *mux.Router, validate *validator.Validate) Evalua
 func (h *EvaluateHandHandler) Register() {
 	h.router.HandleFunc("/evaluate-hand", h.evaluateHand).
 		Methods(http.MethodPost, http.MethodOptions)
+	h.router.HandleFunc("/evaluate-board", h.evaluateBoard).
+		Methods(http.MethodPost, http.MethodOptions)
+	h.router.HandleFunc("/games", h.games).
+		Methods(http.MethodGet, http.MethodOptions)
 }
 
 func (h *EvaluateHandHandler) evaluateHand(w http.ResponseWriter, r *http.Request) {
 	var req evaluateRequest
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJsonErr(w, pokererr.Wrap(err, pokererr.CodeApiDecoderError, nil))
+		writeJsonErr(w, r, pokererr.Wrap(err, pokererr.CodeApiDecoderError, nil))
+		return
+	}
+
+	if err := h.validate.StructCtx(r.Context(), req); err != nil {
+		writeJsonErr(w, r, err)
+		return
+	}
+
+	if req.Game != "" {
+		result, err := holdem.EvaluateGameSeats(req.Game, nil, seatsOf(req.Hands, req.Seats))
+		if err != nil {
+			writeJsonErr(w, r, err)
+			return
+		}
+		metrics.ObserveGameResult(result)
+
+		writeJson(w, r, http.StatusOK, result)
+		return
+	}
+
+	result, err := holdem.EvaluateAndCompareSeats(seatsOf(req.Hands, req.Seats))
+	if err != nil {
+		writeJsonErr(w, r, err)
+		return
+	}
+	metrics.ObserveEvaluateResult(holdem.GameFiveCard, result)
+
+	writeJson(w, r, http.StatusOK, result)
+}
+
+func (h *EvaluateHandHandler) evaluateBoard(w http.ResponseWriter, r *http.Request) {
+	var req evaluateBoardRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJsonErr(w, r, pokererr.Wrap(err, pokererr.CodeApiDecoderError, nil))
 		return
 	}
 
 	if err := h.validate.StructCtx(r.Context(), req); err != nil {
-		writeJsonErr(w, err)
+		writeJsonErr(w, r, err)
 		return
 	}
 
-	result, err := holdem.EvaluateAndCompareHands(req.Hands)
+	if req.Game == "" {
+		req.Game = holdem.GameHoldem
+	}
+
+	result, err := holdem.EvaluateGameSeats(req.Game, req.Board, seatsOf(req.Hands, req.Seats))
 	if err != nil {
-		writeJsonErr(w, err)
+		writeJsonErr(w, r, err)
 		return
 	}
+	metrics.ObserveGameResult(result)
+
+	writeJson(w, r, http.StatusOK, result)
+}
+
+func (h *EvaluateHandHandler) games(w http.ResponseWriter, r *http.Request) {
+	writeJson(w, r, http.StatusOK, gamesResponse{Games: holdem.Games()})
+}
+
+// seatsOf returns the seats of a request, requests in the map form have their hands seated in name order.
+func seatsOf(hands holdem.Hands, seats holdem.Seats) holdem.Seats {
+	if seats != nil {
+		return seats
+	}
 
-	writeJson(w, http.StatusOK, result)
+	return hands.Seats()
 }
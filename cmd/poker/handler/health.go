@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/buildinfo"
+	"github.com/devandreyl/go-poker-hands-evaluator/pkg/holdem"
+	"github.com/gorilla/mux"
+)
+
+const checkOK = "ok"
+
+type healthResponse struct {
+	Status string `json:"status"`
+	// Checks are the results of readiness checks by name, "ok" or the reason of the failure.
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// selfTestDeal is a deal with a known outcome the evaluator is checked with before the service reports ready.
+type selfTestDeal struct {
+	game        holdem.Game
+	board       []string
+	seats       holdem.Seats
+	winners     []string
+	combination string
+}
+
+var selfTestDeals = []selfTestDeal{
+	{
+		game: holdem.GameFiveCard,
+		seats: holdem.Seats{
+			{Name: "flush", Cards: []string{"2H", "7H", "9H", "JH", "KH"}},
+			{Name: "straight", Cards: []string{"9S", "TD", "JC", "QH", "KS"}},
+		},
+		winners:     []string{"flush"},
+		combination: "Flush",
+	},
+	{
+		game:  holdem.GameHoldem,
+		board: []string{"KS", "KH", "7D", "7C", "2S"},
+		seats: holdem.Seats{
+			{Name: "ace", Cards: []string{"AH", "3D"}},
+			{Name: "queen", Cards: []string{"QD", "4C"}},
+		},
+		winners:     []string{"ace"},
+		combination: "Two pair",
+	},
+	{
+		game:  holdem.GameHoldem,
+		board: []string{"AS", "KS", "QS", "JS", "TS"},
+		seats: holdem.Seats{
+			{Name: "first", Cards: []string{"2H", "3D"}},
+			{Name: "second", Cards: []string{"4C", "5D"}},
+		},
+		winners:     []string{"first", "second"},
+		combination: "Royal Flush",
+	},
+}
+
+// Readiness tells whether the service takes new requests. It's ready once the servers listen and it's flipped
+// to not ready before draining on shutdown, so load balancers stop sending requests first.
+type Readiness struct {
+	ready atomic.Bool
+}
+
+func (r *Readiness) SetReady(ready bool) {
+	r.ready.Store(ready)
+}
+
+func (r *Readiness) Ready() bool {
+	return r.ready.Load()
+}
+
+type HealthHandler struct {
+	router    *mux.Router
+	readiness *Readiness
+}
+
+// NewHealthHandler creates the handler of orchestrator probes and build metadata. The router should not have
+// the CORS or auth middleware of the API, probes come from the orchestrator rather than from browsers or clients.
+func NewHealthHandler(router *mux.Router, readiness *Readiness) HealthHandler {
+	return HealthHandler{
+		router:    router,
+		readiness: readiness,
+	}
+}
+
+func (h *HealthHandler) Register() {
+	h.router.HandleFunc("/healthz", h.healthz).
+		Methods(http.MethodGet)
+	h.router.HandleFunc("/readyz", h.readyz).
+		Methods(http.MethodGet)
+	h.router.HandleFunc("/version", h.version).
+		Methods(http.MethodGet)
+}
+
+// healthz tells that the process is alive, it doesn't depend on readiness, so draining doesn't get the process killed.
+func (h *HealthHandler) healthz(w http.ResponseWriter, r *http.Request) {
+	writeJson(w, r, http.StatusOK, healthResponse{Status: checkOK})
+}
+
+func (h *HealthHandler) readyz(w http.ResponseWriter, r *http.Request) {
+	checks := map[string]string{
+		"server":    checkOK,
+		"evaluator": checkOK,
+	}
+	if !h.readiness.Ready() {
+		checks["server"] = "not serving"
+	}
+	if err := evaluatorSelfTest(); err != nil {
+		checks["evaluator"] = err.Error()
+	}
+
+	for _, check := range checks {
+		if check != checkOK {
+			writeJson(w, r, http.StatusServiceUnavailable, healthResponse{Status: "not_ready", Checks: checks})
+			return
+		}
+	}
+
+	writeJson(w, r, http.StatusOK, healthResponse{Status: "ready", Checks: checks})
+}
+
+func (h *HealthHandler) version(w http.ResponseWriter, r *http.Request) {
+	writeJson(w, r, http.StatusOK, buildinfo.Read())
+}
+
+// evaluatorSelfTest evaluates deals with known outcomes and returns the first mismatch.
+func evaluatorSelfTest() error {
+	for i, deal := range selfTestDeals {
+		result, err := holdem.EvaluateGameSeats(deal.game, deal.board, deal.seats)
+		if err != nil {
+			return fmt.Errorf("deal %d: %w", i, err)
+		}
+
+		if !reflect.DeepEqual(result.Winners, deal.winners) {
+			return fmt.Errorf("deal %d: expected winners %v, got %v", i, deal.winners, result.Winners)
+		}
+		if combination := result.Result[deal.winners[0]].CombinationName; combination != deal.combination {
+			return fmt.Errorf("deal %d: expected %s, got %s", i, deal.combination, combination)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/buildinfo"
+	"github.com/gorilla/mux"
+)
+
+func newHealthRouter(readiness *Readiness) *mux.Router {
+	router := mux.NewRouter()
+	healthHandler := NewHealthHandler(router, readiness)
+	healthHandler.Register()
+
+	return router
+}
+
+func TestHealthHandler_Probes(t *testing.T) {
+	readiness := &Readiness{}
+	router := newHealthRouter(readiness)
+
+	tests := []struct {
+		name   string
+		path   string
+		ready  bool
+		status int
+		want   healthResponse
+	}{
+		{
+			name:   "Alive",
+			path:   "/healthz",
+			status: http.StatusOK,
+			want:   healthResponse{Status: "ok"},
+		},
+		{
+			name:   "Ready",
+			path:   "/readyz",
+			ready:  true,
+			status: http.StatusOK,
+			want:   healthResponse{Status: "ready", Checks: map[string]string{"server": "ok", "evaluator": "ok"}},
+		},
+		{
+			name:   "NotReady",
+			path:   "/readyz",
+			status: http.StatusServiceUnavailable,
+			want:   healthResponse{Status: "not_ready", Checks: map[string]string{"server": "not serving", "evaluator": "ok"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			readiness.SetReady(tt.ready)
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if recorder.Code != tt.status {
+				t.Errorf("Expected status %d, but got %d", tt.status, recorder.Code)
+			}
+
+			var got healthResponse
+			if err := json.NewDecoder(recorder.Body).Decode(&got); err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+			if got.Status != tt.want.Status || len(got.Checks) != len(tt.want.Checks) {
+				t.Fatalf("Expected %+v, but got %+v", tt.want, got)
+			}
+			for name, check := range tt.want.Checks {
+				if got.Checks[name] != check {
+					t.Errorf("Expected check %s to be %s, but got %s", name, check, got.Checks[name])
+				}
+			}
+		})
+	}
+}
+
+func TestHealthHandler_Version(t *testing.T) {
+	router := newHealthRouter(&Readiness{})
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/version", nil))
+
+	var got buildinfo.Info
+	if err := json.NewDecoder(recorder.Body).Decode(&got); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if got.Version != buildinfo.Version || got.GoVersion == "" {
+		t.Errorf("Expected build metadata, but got %+v", got)
+	}
+}
+
+func TestEvaluatorSelfTest(t *testing.T) {
+	if err := evaluatorSelfTest(); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/devandreyl/go-poker-hands-evaluator/api"
+	"github.com/gorilla/mux"
+)
+
+type OpenAPIHandler struct {
+	router *mux.Router
+}
+
+func NewOpenAPIHandler(router *mux.Router) OpenAPIHandler {
+	return OpenAPIHandler{
+		router: router,
+	}
+}
+
+func (h *OpenAPIHandler) Register() {
+	h.router.HandleFunc("/openapi.json", h.openAPI).
+		Methods(http.MethodGet, http.MethodOptions)
+}
+
+func (h *OpenAPIHandler) openAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(api.OpenAPI)
+}
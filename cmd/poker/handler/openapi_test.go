@@ -0,0 +1,602 @@
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/devandreyl/go-poker-hands-evaluator/api"
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+	"github.com/devandreyl/go-poker-hands-evaluator/pkg/holdem"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+)
+
+type schema = map[string]any
+
+type openAPIMediaType struct {
+	Schema  schema `json:"schema"`
+	Example any    `json:"example"`
+}
+
+type openAPIResponse struct {
+	Ref     string                      `json:"$ref"`
+	Content map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIOperation struct {
+	RequestBody *struct {
+		Content map[string]openAPIMediaType `json:"content"`
+	} `json:"requestBody"`
+	Responses map[string]openAPIResponse `json:"responses"`
+}
+
+type openAPISpec struct {
+	Paths      map[string]map[string]openAPIOperation `json:"paths"`
+	Components struct {
+		Responses map[string]openAPIResponse `json:"responses"`
+		Schemas   map[string]schema          `json:"schemas"`
+	} `json:"components"`
+}
+
+// contractCase is a request that must get a response described by the spec, besides the examples of the spec.
+type contractCase struct {
+	name        string
+	method      string
+	path        string
+	contentType string
+	accept      string
+	body        string
+	status      int
+}
+
+// schemaTypes are the Go types encoded to or decoded from the component schemas, their JSON fields must match
+// the properties of the schemas. Error is left out, pokererr.Error has its own JSON encoding.
+var schemaTypes = map[string]reflect.Type{
+	"Seat":                 reflect.TypeOf(holdem.Seat{}),
+	"EvaluateHandRequest":  reflect.TypeOf(evaluateRequest{}),
+	"EvaluateBoardRequest": reflect.TypeOf(evaluateBoardRequest{}),
+	"BatchDeal":            reflect.TypeOf(batchDealRequest{}),
+	"BatchDealResult":      reflect.TypeOf(batchDealResponse{}),
+	"Games":                reflect.TypeOf(gamesResponse{}),
+	"EquityRequest":        reflect.TypeOf(equityRequest{}),
+	"RangeEquityRequest":   reflect.TypeOf(rangeEquityRequest{}),
+	"HandResult":           reflect.TypeOf(holdem.HandResult{}),
+	"WildCard":             reflect.TypeOf(holdem.WildCard{}),
+	"RankingPlace":         reflect.TypeOf(holdem.RankingPlace{}),
+	"EvaluateResult":       reflect.TypeOf(holdem.EvaluateResult{}),
+	"GameResult":           reflect.TypeOf(holdem.GameResult{}),
+	"HandEquity":           reflect.TypeOf(holdem.HandEquity{}),
+	"EquityResult":         reflect.TypeOf(holdem.EquityResult{}),
+	"ComboEquity":          reflect.TypeOf(holdem.ComboEquity{}),
+	"RangeEquity":          reflect.TypeOf(holdem.RangeEquity{}),
+	"RangeEquityResult":    reflect.TypeOf(holdem.RangeEquityResult{}),
+	"ErrorResponse":        reflect.TypeOf(errorResponse{}),
+	"ProblemDetails":       reflect.TypeOf(problemResponse{}),
+	"ErrorCodeInfo":        reflect.TypeOf(pokererr.CodeInfo{}),
+	"ErrorCatalog":         reflect.TypeOf(errorCatalogResponse{}),
+}
+
+func loadOpenAPISpec(t *testing.T) *openAPISpec {
+	t.Helper()
+
+	var spec openAPISpec
+	if err := json.Unmarshal(api.OpenAPI, &spec); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	return &spec
+}
+
+func newAPIRouter() *mux.Router {
+	router := mux.NewRouter()
+	RegisterRoutes(router, validator.New(), 2)
+
+	return router
+}
+
+func TestOpenAPI_Routes(t *testing.T) {
+	spec := loadOpenAPISpec(t)
+
+	var documented []string
+	for path, operations := range spec.Paths {
+		for method := range operations {
+			documented = append(documented, strings.ToUpper(method)+" "+APIPrefix+path)
+		}
+	}
+
+	var registered []string
+	err := newAPIRouter().Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		path, err := route.GetPathTemplate()
+		if err != nil || !strings.HasPrefix(path, APIPrefix+"/") {
+			return nil
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			return nil
+		}
+		for _, method := range methods {
+			if method != http.MethodOptions {
+				registered = append(registered, method+" "+path)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	sort.Strings(documented)
+	sort.Strings(registered)
+	if !reflect.DeepEqual(documented, registered) {
+		t.Errorf("Expected routes %v, but got %v", documented, registered)
+	}
+}
+
+func TestOpenAPI_Schemas(t *testing.T) {
+	spec := loadOpenAPISpec(t)
+
+	for name, componentSchema := range spec.Components.Schemas {
+		_, ok := schemaTypes[name]
+		properties := spec.flatten(componentSchema)["properties"].(schema)
+		if !ok && len(properties) > 0 && name != "Error" {
+			t.Errorf("Schema %s has no Go type to be checked against", name)
+		}
+	}
+
+	for name, goType := range schemaTypes {
+		componentSchema, ok := spec.Components.Schemas[name]
+		if !ok {
+			t.Errorf("Schema %s is not described", name)
+			continue
+		}
+
+		if err := spec.checkType(spec.flatten(componentSchema), goType); err != nil {
+			t.Errorf("Schema %s doesn't match %s: %v", name, goType, err)
+		}
+	}
+}
+
+func TestOpenAPI_Examples(t *testing.T) {
+	spec := loadOpenAPISpec(t)
+	router := newAPIRouter()
+
+	var cases []contractCase
+	for path, operations := range spec.Paths {
+		for method, operation := range operations {
+			testCase := contractCase{
+				name:   method + " " + path,
+				method: strings.ToUpper(method),
+				path:   APIPrefix + path,
+				status: http.StatusOK,
+			}
+			if operation.RequestBody != nil {
+				for contentType, mediaType := range operation.RequestBody.Content {
+					testCase.contentType = contentType
+					testCase.body = exampleBody(t, mediaType.Example)
+					if err := spec.validateBody(mediaType.Schema, contentType, []byte(testCase.body)); err != nil {
+						t.Errorf("Example of %s %s doesn't match the spec: %v", method, path, err)
+					}
+				}
+			}
+			cases = append(cases, testCase)
+		}
+	}
+
+	cases = append(cases,
+		contractCase{
+			name:        "invalid card",
+			method:      http.MethodPost,
+			path:        APIPrefix + "/evaluate-board",
+			contentType: "application/json",
+			body:        `{"board": ["2H", "5H", "9H"], "hands": {"first": ["AH", "XX"], "second": ["KS", "KC"]}}`,
+			status:      http.StatusBadRequest,
+		},
+		contractCase{
+			name:        "failed validation",
+			method:      http.MethodPost,
+			path:        APIPrefix + "/evaluate-hand",
+			contentType: "application/json",
+			body:        `{}`,
+			status:      http.StatusBadRequest,
+		},
+		contractCase{
+			name:        "invalid JSON",
+			method:      http.MethodPost,
+			path:        APIPrefix + "/equity",
+			contentType: "application/json",
+			body:        `{`,
+			status:      http.StatusBadRequest,
+		},
+		contractCase{
+			name:        "problem details",
+			method:      http.MethodPost,
+			path:        APIPrefix + "/evaluate-board",
+			contentType: "application/json",
+			accept:      "application/json, application/problem+json",
+			body:        `{"board": ["2H", "5H", "9H"], "hands": {"first": ["AH", "XX"], "second": ["KS", "KC"]}}`,
+			status:      http.StatusBadRequest,
+		},
+		contractCase{
+			name:        "game of evaluate hand",
+			method:      http.MethodPost,
+			path:        APIPrefix + "/evaluate-hand",
+			contentType: "application/json",
+			body:        `{"game": "five-card-jokers", "seats": [{"name": "first", "cards": ["AH", "AD", "JK", "2C", "3S"]}]}`,
+			status:      http.StatusOK,
+		},
+	)
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(testCase.method, testCase.path, strings.NewReader(testCase.body))
+			if testCase.contentType != "" {
+				req.Header.Set("Content-Type", testCase.contentType)
+			}
+			if testCase.accept != "" {
+				req.Header.Set("Accept", testCase.accept)
+			}
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			if recorder.Code != testCase.status {
+				t.Fatalf("Expected status %d, but got %d with %s", testCase.status, recorder.Code, recorder.Body)
+			}
+
+			path := strings.TrimPrefix(testCase.path, APIPrefix)
+			operation := spec.Paths[path][strings.ToLower(testCase.method)]
+			if err := spec.checkResponse(operation, recorder); err != nil {
+				t.Errorf("Response doesn't match the spec: %v", err)
+			}
+		})
+	}
+}
+
+func TestOpenAPI_ErrorCatalog(t *testing.T) {
+	spec := loadOpenAPISpec(t)
+
+	var documented []string
+	for _, code := range spec.Components.Schemas["ErrorCode"]["enum"].([]any) {
+		documented = append(documented, code.(string))
+	}
+
+	var catalog []string
+	for _, info := range pokererr.Catalog() {
+		catalog = append(catalog, string(info.Code))
+	}
+
+	if !reflect.DeepEqual(documented, catalog) {
+		t.Errorf("Expected the codes of the catalog %v in the spec, but got %v", catalog, documented)
+	}
+}
+
+func TestOpenAPI_LegacyRoutes(t *testing.T) {
+	router := newAPIRouter()
+
+	req := httptest.NewRequest(http.MethodGet, "/games", nil)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, but got %d", http.StatusOK, recorder.Code)
+	}
+	if recorder.Header().Get("Deprecation") != "true" {
+		t.Errorf("Expected deprecated response, but got headers %v", recorder.Header())
+	}
+	if link := recorder.Header().Get("Link"); link != `</v1/games>; rel="successor-version"` {
+		t.Errorf("Expected link to /v1/games, but got %s", link)
+	}
+}
+
+func exampleBody(t *testing.T, example any) string {
+	t.Helper()
+
+	if text, ok := example.(string); ok {
+		return text
+	}
+
+	body, err := json.Marshal(example)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	return string(body)
+}
+
+func (s *openAPISpec) checkResponse(operation openAPIOperation, recorder *httptest.ResponseRecorder) error {
+	response, ok := operation.Responses[strconv.Itoa(recorder.Code)]
+	if !ok {
+		return fmt.Errorf("status %d is not described", recorder.Code)
+	}
+	if response.Ref != "" {
+		response = s.Components.Responses[strings.TrimPrefix(response.Ref, "#/components/responses/")]
+	}
+
+	contentType := recorder.Header().Get("Content-Type")
+	mediaType, ok := response.Content[contentType]
+	if !ok {
+		return fmt.Errorf("content type %s is not described", contentType)
+	}
+
+	return s.validateBody(mediaType.Schema, contentType, recorder.Body.Bytes())
+}
+
+// validateBody validates a JSON body or every line of a newline-delimited JSON body.
+func (s *openAPISpec) validateBody(bodySchema schema, contentType string, body []byte) error {
+	var values []any
+	if contentType == ndjsonContentType {
+		scanner := bufio.NewScanner(bytes.NewReader(body))
+		for scanner.Scan() {
+			var value any
+			if err := json.Unmarshal(scanner.Bytes(), &value); err != nil {
+				return err
+			}
+			values = append(values, value)
+		}
+	} else {
+		var value any
+		if err := json.Unmarshal(body, &value); err != nil {
+			return err
+		}
+		values = append(values, value)
+	}
+
+	for _, value := range values {
+		if err := s.validate(bodySchema, value, "$"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *openAPISpec) resolve(current schema) schema {
+	for {
+		ref, ok := current["$ref"].(string)
+		if !ok {
+			return current
+		}
+		current = s.Components.Schemas[strings.TrimPrefix(ref, "#/components/schemas/")]
+	}
+}
+
+// flatten resolves the schema and merges its allOf parts into one object schema.
+func (s *openAPISpec) flatten(current schema) schema {
+	current = s.resolve(current)
+
+	flat := schema{"properties": schema{}}
+	var required []any
+	for key, value := range current {
+		if key != "allOf" && key != "properties" && key != "required" {
+			flat[key] = value
+		}
+	}
+
+	parts := []schema{current}
+	for _, part := range asList(current["allOf"]) {
+		parts = append(parts, s.flatten(part))
+	}
+	for _, part := range parts {
+		if properties, ok := part["properties"].(schema); ok {
+			for name, property := range properties {
+				flat["properties"].(schema)[name] = property
+			}
+		}
+		if partRequired, ok := part["required"].([]any); ok {
+			required = append(required, partRequired...)
+		}
+		if part["type"] != nil {
+			flat["type"] = part["type"]
+		}
+	}
+	flat["required"] = required
+
+	return flat
+}
+
+// validate checks the value against the subset of JSON schema used by the spec. Objects with properties are closed:
+// a response with a property the spec doesn't describe is as much of a drift as a missing one.
+func (s *openAPISpec) validate(current schema, value any, path string) error {
+	current = s.flatten(current)
+
+	if value == nil {
+		if nullable, _ := current["nullable"].(bool); nullable || current["type"] == nil {
+			return nil
+		}
+		return fmt.Errorf("%s is null", path)
+	}
+
+	if anyOf := asList(current["anyOf"]); len(anyOf) > 0 {
+		var errs []string
+		for _, option := range anyOf {
+			err := s.validate(option, value, path)
+			if err == nil {
+				return nil
+			}
+			errs = append(errs, err.Error())
+		}
+		return fmt.Errorf("%s matches none of the schemas: %s", path, strings.Join(errs, "; "))
+	}
+
+	switch current["type"] {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s is not an object", path)
+		}
+		for _, name := range current["required"].([]any) {
+			if _, ok := object[name.(string)]; !ok {
+				return fmt.Errorf("%s.%s is required", path, name)
+			}
+		}
+		properties := current["properties"].(schema)
+		for name, property := range object {
+			if propertySchema, ok := properties[name].(schema); ok {
+				if err := s.validate(propertySchema, property, path+"."+name); err != nil {
+					return err
+				}
+				continue
+			}
+			if additional, ok := current["additionalProperties"].(schema); ok {
+				if err := s.validate(additional, property, path+"."+name); err != nil {
+					return err
+				}
+				continue
+			}
+			if len(properties) > 0 {
+				return fmt.Errorf("%s.%s is not described", path, name)
+			}
+		}
+	case "array":
+		array, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%s is not an array", path)
+		}
+		items, _ := current["items"].(schema)
+		for i, item := range array {
+			if items == nil {
+				continue
+			}
+			if err := s.validate(items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case "string":
+		text, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s is not a string", path)
+		}
+		if enum, ok := current["enum"].([]any); ok && !slices.Contains(enum, any(text)) {
+			return fmt.Errorf("%s %q is not one of %v", path, text, enum)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s is not a boolean", path)
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return fmt.Errorf("%s is not a number", path)
+		}
+	case "integer":
+		if number, ok := value.(float64); !ok || number != math.Trunc(number) {
+			return fmt.Errorf("%s is not an integer", path)
+		}
+	}
+
+	return nil
+}
+
+// checkType compares the properties of the flat object schema with the JSON fields of the Go type.
+func (s *openAPISpec) checkType(current schema, goType reflect.Type) error {
+	fields := jsonFields(goType)
+	properties := current["properties"].(schema)
+
+	for name := range properties {
+		if _, ok := fields[name]; !ok {
+			return fmt.Errorf("property %s has no field", name)
+		}
+	}
+
+	required := make(map[string]bool)
+	for _, name := range current["required"].([]any) {
+		required[name.(string)] = true
+	}
+
+	for name, field := range fields {
+		property, ok := properties[name].(schema)
+		if !ok {
+			return fmt.Errorf("field %s is not described", name)
+		}
+		if required[name] && strings.Contains(field.Tag.Get("json"), ",omitempty") {
+			return fmt.Errorf("field %s is omitted when empty, but it's required", name)
+		}
+
+		propertyType, _ := s.flatten(property)["type"].(string)
+		if kind := jsonKind(field.Type); propertyType != "" && kind != "" && kind != propertyType {
+			return fmt.Errorf("field %s is %s, but the property is %s", name, kind, propertyType)
+		}
+	}
+
+	return nil
+}
+
+// jsonFields returns the fields the type is encoded with by encoding/json, fields of embedded structs included.
+func jsonFields(goType reflect.Type) map[string]reflect.StructField {
+	for goType.Kind() == reflect.Pointer {
+		goType = goType.Elem()
+	}
+
+	fields := make(map[string]reflect.StructField)
+	for i := 0; i < goType.NumField(); i++ {
+		field := goType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" {
+			for embeddedName, embedded := range jsonFields(field.Type) {
+				fields[embeddedName] = embedded
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field
+	}
+
+	return fields
+}
+
+func jsonKind(goType reflect.Type) string {
+	for goType.Kind() == reflect.Pointer {
+		goType = goType.Elem()
+	}
+	if goType == reflect.TypeOf(json.RawMessage{}) {
+		return ""
+	}
+
+	switch goType.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	}
+
+	return ""
+}
+
+func asList(value any) []schema {
+	list, _ := value.([]any)
+
+	schemas := make([]schema, 0, len(list))
+	for _, item := range list {
+		if itemSchema, ok := item.(schema); ok {
+			schemas = append(schemas, itemSchema)
+		}
+	}
+
+	return schemas
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/ratelimit"
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+	"github.com/gorilla/mux"
+)
+
+// RateLimit rejects requests of client IP addresses over the limit with 429 and the Retry-After header. Clients are
+// told apart by the address of the connection, a proxy in front of the service is limited as one client.
+func RateLimit(limiter *ratelimit.Limiter) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			client, _, err := net.SplitHostPort(r.RemoteAddr)
+			if err != nil {
+				client = r.RemoteAddr
+			}
+
+			allowed, retryAfter := limiter.Allow(client)
+			if allowed {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			seconds := int(math.Ceil(retryAfter.Seconds()))
+			w.Header().Set("Retry-After", strconv.Itoa(seconds))
+			writeJsonErr(w, r, pokererr.NewError(pokererr.CodeApiRateLimited, pokererr.Data{"retryAfter": seconds}))
+		})
+	}
+}
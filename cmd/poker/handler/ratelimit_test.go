@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/ratelimit"
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+func TestRateLimit(t *testing.T) {
+	spec := loadOpenAPISpec(t)
+	h := RateLimit(ratelimit.New(0.5, 1))(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		status     int
+	}{
+		{name: "First request", remoteAddr: "192.0.2.1:1000", status: http.StatusNoContent},
+		{name: "Over the limit", remoteAddr: "192.0.2.1:1001", status: http.StatusTooManyRequests},
+		{name: "Another client", remoteAddr: "192.0.2.2:1000", status: http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/games", nil)
+			req.RemoteAddr = tt.remoteAddr
+			recorder := httptest.NewRecorder()
+			h.ServeHTTP(recorder, req)
+
+			if recorder.Code != tt.status {
+				t.Fatalf("Expected status %d, but got %d", tt.status, recorder.Code)
+			}
+			if tt.status != http.StatusTooManyRequests {
+				return
+			}
+
+			if err := spec.checkResponse(spec.Paths["/games"]["get"], recorder); err != nil {
+				t.Errorf("Response doesn't match the spec: %v", err)
+			}
+			if retryAfter := recorder.Header().Get("Retry-After"); retryAfter != "2" {
+				t.Errorf("Expected Retry-After 2, but got %q", retryAfter)
+			}
+			var resp struct {
+				Error pokererr.Error `json:"error"`
+			}
+			if err := json.Unmarshal(recorder.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+			if resp.Error.Code != pokererr.CodeApiRateLimited {
+				t.Errorf("Expected error code %s, but got %s", pokererr.CodeApiRateLimited, resp.Error.Code)
+			}
+		})
+	}
+}
@@ -3,27 +3,111 @@ package handler
 import (
 	"encoding/json"
 	"errors"
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/metrics"
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/middleware"
 	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
-	"log"
+	"log/slog"
+	"mime"
 	"net/http"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 )
 
+const problemContentType = "application/problem+json"
+
 type errorResponse struct {
 	Error error `json:"error"`
+	// RequestID is the ID of the request in the logs, the same as in the X-Request-ID header.
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// problemResponse is the RFC 7807 problem details of an error, for clients that accept application/problem+json.
+// The type is the entry of the code in the error catalog, code, data and requestId are the same as in errorResponse.
+type problemResponse struct {
+	Type      string        `json:"type"`
+	Title     string        `json:"title"`
+	Status    int           `json:"status"`
+	Instance  string        `json:"instance"`
+	Code      pokererr.Code `json:"code"`
+	Data      pokererr.Data `json:"data"`
+	RequestID string        `json:"requestId,omitempty"`
+}
+
+func writeJson(w http.ResponseWriter, r *http.Request, status int, resp any) {
+	writeBody(w, r, status, "application/json", resp)
 }
 
-func writeJson(w http.ResponseWriter, status int, resp any) {
-	w.Header().Set("Content-Type", "application/json")
+func writeBody(w http.ResponseWriter, r *http.Request, status int, contentType string, resp any) {
+	w.Header().Set("Content-Type", contentType)
 	w.WriteHeader(status)
 	if err := json.NewEncoder(w).Encode(resp); err != nil {
-		log.Panic(err)
+		// The status is sent already, it's usually the client that went away.
+		slog.WarnContext(r.Context(), "write response", slog.String("error", err.Error()))
 	}
 }
 
-func writeJsonErr(w http.ResponseWriter, err error) {
+func writeJsonErr(w http.ResponseWriter, r *http.Request, err error) {
+	pokerError, status := toPokerError(err)
+
+	level := slog.LevelDebug
+	if status >= http.StatusInternalServerError {
+		level = slog.LevelError
+	}
+	attrs := []slog.Attr{
+		slog.String("code", string(pokerError.Code)),
+		slog.Any("data", pokerError.Data),
+	}
+	if pokerError.Source != nil {
+		attrs = append(attrs, slog.String("source", pokerError.Source.Error()))
+	}
+	slog.Default().LogAttrs(r.Context(), level, "request failed", attrs...)
+
+	requestID := middleware.RequestIDFromContext(r.Context())
+	if !acceptsProblem(r) {
+		writeJson(w, r, status, errorResponse{
+			Error:     pokerError,
+			RequestID: requestID,
+		})
+		return
+	}
+
+	writeBody(w, r, status, problemContentType, problemResponse{
+		Type:      APIPrefix + "/errors#" + string(pokerError.Code),
+		Title:     pokerError.Code.Info().Title,
+		Status:    status,
+		Instance:  r.URL.Path,
+		Code:      pokerError.Code,
+		Data:      pokerError.Data,
+		RequestID: requestID,
+	})
+}
 
+// acceptsProblem tells whether the client asked for problem details in the Accept header, other clients get
+// the error envelope of the first release.
+func acceptsProblem(r *http.Request) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		for _, mediaRange := range strings.Split(accept, ",") {
+			mediaType, params, err := mime.ParseMediaType(mediaRange)
+			if err == nil && mediaType == problemContentType && params["q"] != "0" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// toPokerError converts any error to the error returned to clients and the HTTP status of the response.
+// Every error returned to clients passes here, so errors caused by the client input are counted here.
+func toPokerError(err error) (*pokererr.Error, int) {
+	pokerError, status := convertError(err)
+	metrics.ObserveError(pokerError)
+
+	return pokerError, status
+}
+
+func convertError(err error) (*pokererr.Error, int) {
 	var validationErrors validator.ValidationErrors
 	if errors.As(err, &validationErrors) {
 		data := make(pokererr.Data)
@@ -31,23 +115,13 @@ func writeJsonErr(w http.ResponseWriter, err error) {
 			data[v.StructField()] = v.Error()
 		}
 
-		writeJson(w, http.StatusBadRequest, errorResponse{
-			Error: pokererr.NewError(pokererr.CodeValidationError, data),
-		})
-
-		return
+		return pokererr.NewError(pokererr.CodeValidationError, data), pokererr.CodeValidationError.Status()
 	}
 
 	var pokerError *pokererr.Error
 	if errors.As(err, &pokerError) {
-		writeJson(w, http.StatusInternalServerError, errorResponse{
-			Error: pokerError,
-		})
-
-		return
+		return pokerError, pokerError.Code.Status()
 	}
 
-	writeJson(w, http.StatusInternalServerError, errorResponse{
-		Error: pokererr.Wrap(err, pokererr.CodeUnknown, nil),
-	})
+	return pokererr.Wrap(err, pokererr.CodeUnknown, nil), pokererr.CodeUnknown.Status()
 }
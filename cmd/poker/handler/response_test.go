@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/middleware"
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+func TestWriteJsonErr_RequestID(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/v1/evaluate-hand", nil)
+	req = req.WithContext(middleware.WithRequestID(req.Context(), "req-42"))
+
+	recorder := httptest.NewRecorder()
+	writeJsonErr(recorder, req, pokererr.NewError(pokererr.CodeInvalidCard, nil))
+
+	var response struct {
+		Error     pokererr.Error `json:"error"`
+		RequestID string         `json:"requestId"`
+	}
+	if err := json.NewDecoder(recorder.Body).Decode(&response); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("Expected status %d, but got %d", http.StatusBadRequest, recorder.Code)
+	}
+	if response.Error.Code != pokererr.CodeInvalidCard || response.RequestID != "req-42" {
+		t.Errorf("Expected the error with the request ID, but got %+v", response)
+	}
+}
+
+func TestWriteJsonErr_ProblemDetails(t *testing.T) {
+	tests := []struct {
+		name        string
+		accept      string
+		err         error
+		status      int
+		contentType string
+	}{
+		{
+			name:        "Envelope by default",
+			err:         pokererr.NewError(pokererr.CodeInvalidCard, nil),
+			status:      http.StatusBadRequest,
+			contentType: "application/json",
+		},
+		{
+			name:        "Problem details",
+			accept:      "application/problem+json",
+			err:         pokererr.NewError(pokererr.CodeApiLineTooLong, pokererr.Data{"limit": 1}),
+			status:      http.StatusRequestEntityTooLarge,
+			contentType: problemContentType,
+		},
+		{
+			name:        "Problem details among other types",
+			accept:      "application/json;q=0.9, application/problem+json",
+			err:         errors.New("boom"),
+			status:      http.StatusInternalServerError,
+			contentType: problemContentType,
+		},
+		{
+			name:        "Problem details refused",
+			accept:      "application/problem+json;q=0, application/json",
+			err:         pokererr.NewError(pokererr.CodeInvalidCard, nil),
+			status:      http.StatusBadRequest,
+			contentType: "application/json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/v1/evaluate-batch", nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			recorder := httptest.NewRecorder()
+			writeJsonErr(recorder, req, tt.err)
+
+			if recorder.Code != tt.status {
+				t.Errorf("Expected status %d, but got %d", tt.status, recorder.Code)
+			}
+			if contentType := recorder.Header().Get("Content-Type"); contentType != tt.contentType {
+				t.Fatalf("Expected content type %s, but got %s", tt.contentType, contentType)
+			}
+			if tt.contentType != problemContentType {
+				return
+			}
+
+			var problem problemResponse
+			if err := json.NewDecoder(recorder.Body).Decode(&problem); err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+			info := problem.Code.Info()
+			expected := problemResponse{
+				Type:     "/v1/errors#" + string(problem.Code),
+				Title:    info.Title,
+				Status:   tt.status,
+				Instance: "/v1/evaluate-batch",
+				Code:     problem.Code,
+				Data:     problem.Data,
+			}
+			if problem.Code == "" || !reflect.DeepEqual(problem, expected) {
+				t.Errorf("Expected %+v, but got %+v", expected, problem)
+			}
+		})
+	}
+}
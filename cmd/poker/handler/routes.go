@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+)
+
+// APIPrefix is the prefix of the current version of the API, it's described by api/openapi.json.
+const APIPrefix = "/v1"
+
+// RegisterRoutes registers all handlers under APIPrefix. The same handlers stay on the unversioned routes of the
+// first release, marked as deprecated, so existing clients keep working until they move to the versioned ones.
+func RegisterRoutes(router *mux.Router, validate *validator.Validate, batchWorkers int) {
+	v1 := router.PathPrefix(APIPrefix).Subrouter()
+	registerAPI(v1, validate, batchWorkers)
+	openAPIHandler := NewOpenAPIHandler(v1)
+	openAPIHandler.Register()
+	errorsHandler := NewErrorsHandler(v1)
+	errorsHandler.Register()
+
+	legacy := router.NewRoute().Subrouter()
+	legacy.Use(deprecated)
+	registerAPI(legacy, validate, batchWorkers)
+}
+
+func registerAPI(router *mux.Router, validate *validator.Validate, batchWorkers int) {
+	evaluateHandler := NewEvaluateHandler(router, validate)
+	evaluateHandler.Register()
+	equityHandler := NewEquityHandler(router, validate)
+	equityHandler.Register()
+	batchHandler := NewBatchHandler(router, validate, batchWorkers)
+	batchHandler.Register()
+}
+
+// deprecated marks responses of unversioned routes, the successor is the same route under APIPrefix.
+func deprecated(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Link", "<"+APIPrefix+r.URL.Path+`>; rel="successor-version"`)
+		next.ServeHTTP(w, r)
+	})
+}
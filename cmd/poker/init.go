@@ -1,26 +1,174 @@
 package main
 
 import (
-	"fmt"
+	"crypto/tls"
+	"errors"
+	"github.com/devandreyl/go-poker-hands-evaluator/cmd/poker/handler"
+	"github.com/devandreyl/go-poker-hands-evaluator/cmd/poker/rpc"
 	"github.com/devandreyl/go-poker-hands-evaluator/internal/config"
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/logging"
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/metrics"
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/middleware"
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/ratelimit"
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/tlscert"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/rs/cors"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"log/slog"
 	"net/http"
+	"os"
+	"sync/atomic"
 )
 
+// MustReadConfig reads the config or exits, with the zero code after printing the help or the config and with
+// the code 2 on invalid flags, values or config files.
 func MustReadConfig() *config.Config {
 	cnf, err := config.ReadConfig(true)
+	if errors.Is(err, pflag.ErrHelp) {
+		os.Exit(0)
+	}
 	if err != nil {
-		panic(fmt.Sprintf("read config: %s", err))
+		slog.Error("read config", slog.String("error", err.Error()))
+		os.Exit(2)
+	}
+
+	if cnf.Print {
+		if err := cnf.Write(os.Stdout); err != nil {
+			slog.Error("print config", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		os.Exit(0)
 	}
 
 	return cnf
 }
 
+// Settings are the settings applied again when the config is reloaded: the log level, and the CORS options and
+// the rate limits of the API. The rest of the config is read at startup only.
+type Settings struct {
+	cors    atomic.Pointer[cors.Cors]
+	limiter *ratelimit.Limiter
+}
+
+func NewSettings(cnf *config.Config) *Settings {
+	settings := &Settings{limiter: ratelimit.New(cnf.RateLimit.Rate, cnf.RateLimit.Burst)}
+	settings.cors.Store(newCORS(cnf))
+
+	return settings
+}
+
+// Apply applies the reloadable settings of the config.
+func (s *Settings) Apply(cnf *config.Config) error {
+	if err := logging.SetLevel(cnf.Log.Level); err != nil {
+		return err
+	}
+	s.cors.Store(newCORS(cnf))
+	s.limiter.SetLimit(cnf.RateLimit.Rate, cnf.RateLimit.Burst)
+
+	return nil
+}
+
+// CORS is the CORS middleware with the current options.
+func (s *Settings) CORS(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.cors.Load().ServeHTTP(w, r, next.ServeHTTP)
+	})
+}
+
+func newCORS(cnf *config.Config) *cors.Cors {
+	return cors.New(cors.Options{
+		AllowedOrigins:   cnf.CORS.Origins,
+		AllowedMethods:   cnf.CORS.Methods,
+		AllowedHeaders:   cnf.CORS.Headers,
+		AllowCredentials: true,
+	})
+}
+
+// MustSetupLogging makes JSON logs of the configured level the default logs or exits with the code 2.
+func MustSetupLogging(cnf *config.Config) {
+	slog.SetDefault(logging.New(os.Stdout))
+	if err := logging.SetLevel(cnf.Log.Level); err != nil {
+		slog.Error("read config", slog.String("error", err.Error()), slog.String("log-level", cnf.Log.Level))
+		os.Exit(2)
+	}
+}
+
+// CreateRouter returns the handler of all HTTP routes. Probes, metrics and build metadata are served by the root
+// router, the API is served by its own subrouter, so the middleware of the API, like CORS and rate limits, doesn't
+// apply to probes. Requests of all routes are measured, and every request gets a request ID and an access log line.
+func CreateRouter(cnf *config.Config, readiness *handler.Readiness, settings *Settings) http.Handler {
+	router := mux.NewRouter()
+	router.Use(metrics.Middleware)
+	healthHandler := handler.NewHealthHandler(router, readiness)
+	healthHandler.Register()
+	router.Handle("/metrics", metrics.Handler()).
+		Methods(http.MethodGet)
+
+	apiRouter := router.NewRoute().Subrouter()
+	// Rejected requests get CORS headers too, so browsers let the client read the error.
+	apiRouter.Use(settings.CORS, handler.RateLimit(settings.limiter))
+	handler.RegisterRoutes(apiRouter, validator.New(), cnf.Batch.Workers)
+
+	return middleware.RequestID(middleware.AccessLog(slog.Default())(router))
+}
+
+// MustCreateTLSConfig returns the TLS config of the servers, nil when TLS isn't configured, or exits with the code 2
+// when the certificate doesn't load.
+func MustCreateTLSConfig(cnf *config.Config) *tls.Config {
+	if cnf.TLS.CertFile == "" {
+		return nil
+	}
+
+	reloader, err := tlscert.NewReloader(cnf.TLS.CertFile, cnf.TLS.KeyFile, cnf.TLS.Reload)
+	if err != nil {
+		slog.Error("read config", slog.String("error", err.Error()), slog.String("tls-cert", cnf.TLS.CertFile))
+		os.Exit(2)
+	}
+
+	return reloader.TLSConfig()
+}
+
+// CreateHTTPServer returns the HTTP server, it serves HTTPS when the TLS config isn't nil.
 func CreateHTTPServer(
 	cnf *config.Config,
 	h http.Handler,
+	tlsConfig *tls.Config,
 ) *http.Server {
 	return &http.Server{
-		Handler: h,
-		Addr:    cnf.HTTP.Listen,
+		Handler:           h,
+		Addr:              cnf.HTTP.Listen,
+		TLSConfig:         tlsConfig,
+		ReadHeaderTimeout: cnf.HTTP.ReadHeaderTimeout,
+		ReadTimeout:       cnf.HTTP.ReadTimeout,
+		WriteTimeout:      cnf.HTTP.WriteTimeout,
+		IdleTimeout:       cnf.HTTP.IdleTimeout,
+		MaxHeaderBytes:    cnf.HTTP.MaxHeaderBytes,
 	}
 }
+
+// CreateGRPCServer returns the gRPC server with the evaluator and the standard health services, or nils when the gRPC
+// listener is disabled. The health service reports the server as serving until it's shut down. The server uses TLS
+// when the TLS config isn't nil.
+func CreateGRPCServer(cnf *config.Config, tlsConfig *tls.Config) (*grpc.Server, *health.Server) {
+	if cnf.GRPC.Listen == "" {
+		return nil, nil
+	}
+
+	var options []grpc.ServerOption
+	if tlsConfig != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	server := grpc.NewServer(options...)
+	rpc.NewEvaluatorServer(cnf.Batch.Workers).Register(server)
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	return server, healthServer
+}
@@ -3,74 +3,219 @@ package main
 import (
 	"context"
 	"github.com/devandreyl/go-poker-hands-evaluator/cmd/poker/handler"
-	"github.com/go-playground/validator/v10"
-	"github.com/rs/cors"
-	"log"
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"log/slog"
+	"net"
 	"net/http"
+	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
-	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 )
 
+// application holds the servers of the service and the readiness they report.
+type application struct {
+	http       *http.Server
+	grpc       *grpc.Server
+	grpcHealth *health.Server
+	readiness  *handler.Readiness
+}
+
 func main() {
 	var (
 		ctx, stop = signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 		config    = MustReadConfig()
+		readiness = &handler.Readiness{}
 	)
+	defer stop()
 
-	router := mux.NewRouter()
-	evaluateHandler := handler.NewEvaluateHandler(router, validator.New())
-	evaluateHandler.Register()
+	MustSetupLogging(config)
 
-	corsMiddleware := cors.New(cors.Options{
-		AllowedOrigins:   []string{"http://localhost:3000"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE"},
-		AllowedHeaders:   []string{"Content-Type"},
-		AllowCredentials: true,
-	})
+	settings := NewSettings(config)
+	go reloadOnHangup(ctx, config, settings)
+
+	tlsConfig := MustCreateTLSConfig(config)
+	grpcServer, grpcHealth := CreateGRPCServer(config, tlsConfig)
+	app := &application{
+		http:       CreateHTTPServer(config, CreateRouter(config, readiness, settings), tlsConfig),
+		grpc:       grpcServer,
+		grpcHealth: grpcHealth,
+		readiness:  readiness,
+	}
 
-	routerWithCORS := corsMiddleware.Handler(router)
+	if err := run(ctx, stop, config, app); err != nil {
+		slog.Error("poker stopped", slog.String("error", err.Error()))
+		stop()
+		os.Exit(1)
+	}
+}
 
-	server := CreateHTTPServer(config, routerWithCORS)
+// reloadOnHangup reads the config again on SIGHUP and applies the settings that don't need a restart, until the
+// context is done. An invalid config is logged and the current settings are kept.
+func reloadOnHangup(ctx context.Context, cnf *config.Config, settings *Settings) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
 
-	run(ctx, stop, server)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hangup:
+		}
+
+		next, err := cnf.Reload()
+		if err == nil {
+			err = settings.Apply(next)
+		}
+		if err != nil {
+			slog.Error("reload config", slog.String("error", err.Error()))
+			continue
+		}
+
+		if cnf.NeedsRestart(next) {
+			slog.Warn("config reloaded, changes of settings other than log level, CORS and rate limits need a restart")
+			continue
+		}
+		slog.Info("config reloaded", slog.String("config", next.File))
+	}
 }
 
-func run(
-	ctx context.Context,
-	stop context.CancelFunc,
-	server *http.Server,
-) {
-	errCh := make(chan error)
+// run serves HTTP and gRPC until the context is done or one of the servers fails, then drains both of them.
+// The returned error is the failure of a server or of draining, nil after a clean shutdown.
+func run(ctx context.Context, stop context.CancelFunc, cnf *config.Config, app *application) error {
+	httpListener, err := net.Listen("tcp", cnf.HTTP.Listen)
+	if err != nil {
+		return errors.Wrap(err, "http server")
+	}
 
-	go func() {
-		err := server.ListenAndServe()
+	var grpcListener net.Listener
+	if app.grpc != nil {
+		grpcListener, err = net.Listen("tcp", cnf.GRPC.Listen)
 		if err != nil {
-			select {
-			case <-ctx.Done():
-			case errCh <- errors.Wrap(err, "http server"):
-			}
+			_ = httpListener.Close()
+			return errors.Wrap(err, "grpc server")
+		}
+	}
+
+	errCh := make(chan error, 2)
+	// Serving writes the TLS config of the server, so it's read once before.
+	tls := app.http.TLSConfig != nil
+
+	go func() {
+		var err error
+		if tls {
+			err = app.http.ServeTLS(httpListener, "", "")
+		} else {
+			err = app.http.Serve(httpListener)
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- errors.Wrap(err, "http server")
 		}
 	}()
 
-	shutdown := func(err error) {
-		_, cancelTimeout := context.WithTimeout(context.Background(), 15*time.Second)
-		defer cancelTimeout()
+	if app.grpc != nil {
+		go func() {
+			if err := app.grpc.Serve(grpcListener); err != nil {
+				errCh <- errors.Wrap(err, "grpc server")
+			}
+		}()
+	}
 
-		stop()
+	app.readiness.SetReady(true)
+	slog.Info("poker started",
+		slog.String("http", cnf.HTTP.Listen),
+		slog.String("grpc", cnf.GRPC.Listen),
+		slog.Bool("tls", tls),
+	)
 
-		if err != nil {
-			log.Panic("shutdown caused by error")
+	var serveErr error
+	select {
+	case <-ctx.Done():
+	case serveErr = <-errCh:
+	}
+
+	// A second signal kills the process right away instead of waiting for draining.
+	stop()
+	slog.Info("poker shutting down",
+		slog.String("delay", cnf.Shutdown.Delay.String()),
+		slog.String("timeout", cnf.Shutdown.Timeout.String()),
+	)
+
+	shutdownErr := shutdown(cnf, app, serveErr == nil)
+	if serveErr != nil {
+		return serveErr
+	}
+
+	return shutdownErr
+}
+
+// shutdown flips readiness, waits for the shutdown delay when the servers are healthy, so load balancers stop sending
+// new requests, and then gives in-flight requests the shutdown timeout to finish before closing their connections.
+func shutdown(cnf *config.Config, app *application, delay bool) error {
+	app.readiness.SetReady(false)
+	if app.grpcHealth != nil {
+		app.grpcHealth.Shutdown()
+	}
+
+	if delay {
+		time.Sleep(cnf.Shutdown.Delay)
+	}
+
+	shutdownCtx, cancelTimeout := context.WithTimeout(context.Background(), cnf.Shutdown.Timeout)
+	defer cancelTimeout()
+
+	var (
+		wg               sync.WaitGroup
+		httpErr, grpcErr error
+	)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := app.http.Shutdown(shutdownCtx); err != nil {
+			_ = app.http.Close()
+			httpErr = errors.Wrap(err, "http server shutdown")
 		}
+	}()
+
+	if app.grpc != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := stopGRPCServer(shutdownCtx, app.grpc); err != nil {
+				grpcErr = errors.Wrap(err, "grpc server shutdown")
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if httpErr != nil {
+		return httpErr
 	}
 
+	return grpcErr
+}
+
+// stopGRPCServer waits for running calls to finish and closes them when the context is done first.
+func stopGRPCServer(ctx context.Context, server *grpc.Server) error {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
 	select {
+	case <-stopped:
+		return nil
 	case <-ctx.Done():
-		shutdown(nil)
-	case err := <-errCh:
-		shutdown(err)
+		server.Stop()
+		return ctx.Err()
 	}
 }
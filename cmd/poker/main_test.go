@@ -0,0 +1,246 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/devandreyl/go-poker-hands-evaluator/cmd/poker/handler"
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/config"
+)
+
+func freeAddress(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	defer listener.Close()
+
+	return listener.Addr().String()
+}
+
+func newTestApplication(cnf *config.Config, h http.Handler) *application {
+	return &application{
+		http:      CreateHTTPServer(cnf, h, nil),
+		readiness: &handler.Readiness{},
+	}
+}
+
+func waitReady(t *testing.T, readiness *handler.Readiness) {
+	t.Helper()
+
+	for deadline := time.Now().Add(5 * time.Second); !readiness.Ready(); {
+		if time.Now().After(deadline) {
+			t.Fatal("Expected the service to get ready")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestRun_ListenError(t *testing.T) {
+	busy, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	defer busy.Close()
+
+	cnf := &config.Config{}
+	cnf.HTTP.Listen = busy.Addr().String()
+	app := newTestApplication(cnf, http.NotFoundHandler())
+
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+
+	err = run(ctx, stop, cnf, app)
+	if err == nil || !strings.Contains(err.Error(), "http server") {
+		t.Errorf("Expected the listen error of the http server, but got %v", err)
+	}
+	if app.readiness.Ready() {
+		t.Error("Expected the service not to be ready")
+	}
+}
+
+func TestRun_DrainsInFlightRequests(t *testing.T) {
+	cnf := &config.Config{}
+	cnf.HTTP.Listen = freeAddress(t)
+	cnf.Shutdown.Delay = 100 * time.Millisecond
+	cnf.Shutdown.Timeout = 5 * time.Second
+
+	started := make(chan struct{})
+	app := newTestApplication(cnf, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		time.Sleep(300 * time.Millisecond)
+		_, _ = io.WriteString(w, "done")
+	}))
+
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- run(ctx, stop, cnf, app)
+	}()
+	waitReady(t, app.readiness)
+
+	type result struct {
+		body string
+		err  error
+	}
+	responses := make(chan result, 1)
+	go func() {
+		resp, err := http.Get("http://" + cnf.HTTP.Listen)
+		if err != nil {
+			responses <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		responses <- result{body: string(body), err: err}
+	}()
+
+	<-started
+	stop()
+
+	time.Sleep(20 * time.Millisecond)
+	if app.readiness.Ready() {
+		t.Error("Expected the service to be not ready while draining")
+	}
+
+	response := <-responses
+	if response.err != nil || response.body != "done" {
+		t.Errorf("Expected the in-flight request to finish, but got %q and %v", response.body, response.err)
+	}
+	if err := <-runErr; err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestRun_ShutdownTimeout(t *testing.T) {
+	cnf := &config.Config{}
+	cnf.HTTP.Listen = freeAddress(t)
+	cnf.Shutdown.Timeout = 100 * time.Millisecond
+
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	app := newTestApplication(cnf, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		<-release
+	}))
+
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- run(ctx, stop, cnf, app)
+	}()
+	waitReady(t, app.readiness)
+
+	go func() {
+		resp, err := http.Get("http://" + cnf.HTTP.Listen)
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+
+	<-started
+	stop()
+
+	err := <-runErr
+	if err == nil || !strings.Contains(err.Error(), "http server shutdown") {
+		t.Errorf("Expected the shutdown timeout error, but got %v", err)
+	}
+}
+
+func TestCreateRouter_CORS(t *testing.T) {
+	cnf := &config.Config{}
+	cnf.CORS.Origins = []string{"https://poker.example"}
+	cnf.CORS.Methods = []string{http.MethodGet, http.MethodPost}
+	cnf.CORS.Headers = []string{"Content-Type"}
+	router := CreateRouter(cnf, &handler.Readiness{}, NewSettings(cnf))
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		origin string
+		cors   bool
+	}{
+		{name: "API", method: http.MethodGet, path: "/v1/games", cors: true},
+		{name: "Preflight", method: http.MethodOptions, path: "/v1/evaluate-hand", cors: true},
+		{name: "Not allowed origin", method: http.MethodGet, path: "/v1/games", origin: "http://localhost:3000"},
+		{name: "Liveness", method: http.MethodGet, path: "/healthz"},
+		{name: "Readiness", method: http.MethodGet, path: "/readyz"},
+		{name: "Version", method: http.MethodGet, path: "/version"},
+		{name: "Metrics", method: http.MethodGet, path: "/metrics"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			origin := tt.origin
+			if origin == "" {
+				origin = "https://poker.example"
+			}
+			req.Header.Set("Origin", origin)
+			req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			if recorder.Code == http.StatusNotFound {
+				t.Fatalf("Expected %s to be routed", tt.path)
+			}
+			if got := recorder.Header().Get("Access-Control-Allow-Origin") != ""; got != tt.cors {
+				t.Errorf("Expected CORS headers %t, but got %t", tt.cors, got)
+			}
+		})
+	}
+}
+
+func TestSettings_Apply(t *testing.T) {
+	cnf := &config.Config{}
+	cnf.Log.Level = "info"
+	cnf.CORS.Origins = []string{"https://a.example"}
+	settings := NewSettings(cnf)
+	router := CreateRouter(cnf, &handler.Readiness{}, settings)
+
+	next := *cnf
+	next.CORS.Origins = []string{"https://b.example"}
+	next.RateLimit.Rate, next.RateLimit.Burst = 1, 1
+	if err := settings.Apply(&next); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		origin string
+		status int
+		cors   bool
+	}{
+		{name: "New origin", origin: "https://b.example", status: http.StatusOK, cors: true},
+		{name: "Over the new limit", origin: "https://a.example", status: http.StatusTooManyRequests},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/games", nil)
+			req.Header.Set("Origin", tt.origin)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			if recorder.Code != tt.status {
+				t.Errorf("Expected status %d, but got %d", tt.status, recorder.Code)
+			}
+			if got := recorder.Header().Get("Access-Control-Allow-Origin") != ""; got != tt.cors {
+				t.Errorf("Expected CORS headers %t, but got %t", tt.cors, got)
+			}
+		})
+	}
+}
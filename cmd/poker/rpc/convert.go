@@ -0,0 +1,109 @@
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/metrics"
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+	"github.com/devandreyl/go-poker-hands-evaluator/pkg/holdem"
+	"github.com/devandreyl/go-poker-hands-evaluator/pkg/pokerpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func toCompareResponse(result *holdem.GameResult) *pokerpb.CompareResponse {
+	return &pokerpb.CompareResponse{
+		Game:   string(result.Game),
+		High:   toEvaluateResult(result.EvaluateResult),
+		Low:    toEvaluateResult(result.Low),
+		Shares: result.Shares,
+	}
+}
+
+func toEvaluateResult(result *holdem.EvaluateResult) *pokerpb.EvaluateResult {
+	if result == nil {
+		return nil
+	}
+
+	converted := &pokerpb.EvaluateResult{Winners: result.Winners}
+	for _, handResult := range result.Seats {
+		converted.Seats = append(converted.Seats, toHandResult(handResult))
+	}
+	for _, place := range result.Ranking {
+		converted.Ranking = append(converted.Ranking, &pokerpb.RankingPlace{
+			Place:           int32(place.Place),
+			Hands:           place.Hands,
+			CombinationName: place.CombinationName,
+			Rank:            uint32(place.Rank),
+		})
+	}
+	for _, tie := range result.Ties {
+		converted.Ties = append(converted.Ties, &pokerpb.Tie{Hands: tie})
+	}
+
+	return converted
+}
+
+func toHandResult(result *holdem.HandResult) *pokerpb.HandResult {
+	converted := &pokerpb.HandResult{
+		HandName:          result.HandName,
+		CombinationName:   result.CombinationName,
+		HandWeight:        result.HandWeight,
+		CombinationWeight: result.CombinationWeight,
+		Rank:              uint32(result.Rank),
+		BestCards:         result.BestCards,
+		Kickers:           result.Kickers,
+		Description:       result.Description,
+	}
+	for _, wildCard := range result.WildCards {
+		converted.WildCards = append(converted.WildCards, &pokerpb.WildCard{
+			Card:    wildCard.Card,
+			PlaysAs: wildCard.PlaysAs,
+		})
+	}
+
+	return converted
+}
+
+// toError converts any error to the error returned to clients, unknown errors get the CodeUnknown code.
+// Every error returned to clients passes here, so errors caused by the client input are counted here.
+func toError(err error) *pokerpb.Error {
+	var pokerError *pokererr.Error
+	if !errors.As(err, &pokerError) {
+		pokerError = pokererr.Wrap(err, pokererr.CodeUnknown, nil)
+	}
+	metrics.ObserveError(pokerError)
+
+	converted := &pokerpb.Error{Code: string(pokerError.Code)}
+
+	// Data holds nested values like lists of invalid cards, JSON is the simplest way to turn them into a Struct.
+	if data, err := json.Marshal(pokerError.Data); err == nil {
+		var fields structpb.Struct
+		if protojson.Unmarshal(data, &fields) == nil {
+			converted.Data = &fields
+		}
+	}
+
+	return converted
+}
+
+// toStatusError converts any error to a gRPC status error with the Error in its details. Errors caused by the client
+// input have the InvalidArgument code.
+func toStatusError(err error) error {
+	converted := toError(err)
+
+	code := codes.Internal
+	if pokererr.Code(converted.GetCode()).IsClientError() {
+		code = codes.InvalidArgument
+	}
+
+	st, detailsErr := status.New(code, converted.GetCode()).WithDetails(converted)
+	if detailsErr != nil {
+		return status.Error(code, converted.GetCode())
+	}
+
+	return st.Err()
+}
@@ -0,0 +1,137 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"runtime"
+
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/batch"
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/metrics"
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+	"github.com/devandreyl/go-poker-hands-evaluator/pkg/holdem"
+	"github.com/devandreyl/go-poker-hands-evaluator/pkg/pokerpb"
+	"google.golang.org/grpc"
+)
+
+// batchWindowPerWorker bounds deals that are received but not sent back yet, so a client that doesn't receive results
+// stops the server from receiving more deals.
+const batchWindowPerWorker = 2
+
+type EvaluatorServer struct {
+	pokerpb.UnimplementedEvaluatorServer
+	workers int
+}
+
+// NewEvaluatorServer creates the gRPC evaluator, every batch stream is evaluated by the given number of workers,
+// zero means the number of CPUs.
+func NewEvaluatorServer(workers int) *EvaluatorServer {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	return &EvaluatorServer{workers: workers}
+}
+
+func (s *EvaluatorServer) Register(server *grpc.Server) {
+	pokerpb.RegisterEvaluatorServer(server, s)
+}
+
+func (s *EvaluatorServer) Evaluate(_ context.Context, req *pokerpb.EvaluateRequest) (*pokerpb.EvaluateResponse, error) {
+	result, err := holdem.EvaluateGameSeats(gameOf(req.GetGame(), req.GetBoard()), req.GetBoard(), holdem.Seats{
+		{Cards: req.GetCards()},
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	metrics.ObserveGameResult(result)
+
+	response := &pokerpb.EvaluateResponse{}
+	if len(result.Seats) > 0 {
+		response.Result = toHandResult(result.Seats[0])
+	}
+
+	return response, nil
+}
+
+func (s *EvaluatorServer) Compare(_ context.Context, req *pokerpb.CompareRequest) (*pokerpb.CompareResponse, error) {
+	result, err := compare(req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return result, nil
+}
+
+// CompareBatch compares deals of the stream by a bounded pool of workers and sends results back in the order of deals.
+func (s *EvaluatorServer) CompareBatch(stream pokerpb.Evaluator_CompareBatchServer) error {
+	var (
+		index   int64
+		recvErr error
+	)
+	read := func() (*pokerpb.CompareBatchRequest, bool) {
+		req, err := stream.Recv()
+		if err != nil {
+			recvErr = err
+			return nil, false
+		}
+		return req, true
+	}
+
+	evaluate := func(_ context.Context, req *pokerpb.CompareBatchRequest) *pokerpb.CompareBatchResponse {
+		response := &pokerpb.CompareBatchResponse{Id: req.GetId()}
+
+		result, err := compare(req.GetDeal())
+		if err != nil {
+			response.Outcome = &pokerpb.CompareBatchResponse_Error{Error: toError(err)}
+			return response
+		}
+		response.Outcome = &pokerpb.CompareBatchResponse_Result{Result: result}
+
+		return response
+	}
+
+	write := func(response *pokerpb.CompareBatchResponse, _ int) error {
+		response.Index = index
+		index++
+		return stream.Send(response)
+	}
+
+	if err := batch.Process(stream.Context(), s.workers, s.workers*batchWindowPerWorker, read, evaluate, write); err != nil {
+		return err
+	}
+
+	// The stream ends with io.EOF when the client closes its side, any other error ends the call with its status.
+	if recvErr != nil && !errors.Is(recvErr, io.EOF) {
+		return recvErr
+	}
+
+	return nil
+}
+
+func compare(req *pokerpb.CompareRequest) (*pokerpb.CompareResponse, error) {
+	if req == nil {
+		return nil, pokererr.NewError(pokererr.CodeValidationError, pokererr.Data{"deal": "required"})
+	}
+
+	seats := make(holdem.Seats, len(req.GetSeats()))
+	for i, seat := range req.GetSeats() {
+		seats[i] = holdem.Seat{Name: seat.GetName(), Cards: seat.GetCards()}
+	}
+
+	result, err := holdem.EvaluateGameSeats(gameOf(req.GetGame(), req.GetBoard()), req.GetBoard(), seats)
+	if err != nil {
+		return nil, err
+	}
+	metrics.ObserveGameResult(result)
+
+	return toCompareResponse(result), nil
+}
+
+func gameOf(game string, board []string) holdem.Game {
+	if game == "" {
+		return holdem.DefaultGame(board)
+	}
+
+	return holdem.Game(game)
+}
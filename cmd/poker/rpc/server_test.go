@@ -0,0 +1,211 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"testing"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+	"github.com/devandreyl/go-poker-hands-evaluator/pkg/pokerpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newClient(t *testing.T, workers int) pokerpb.EvaluatorClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	NewEvaluatorServer(workers).Register(server)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return pokerpb.NewEvaluatorClient(conn)
+}
+
+func TestEvaluatorServer_Evaluate(t *testing.T) {
+	client := newClient(t, 1)
+
+	response, err := client.Evaluate(context.Background(), &pokerpb.EvaluateRequest{
+		Board: []string{"KS", "KH", "7D", "7C", "2S"},
+		Cards: []string{"AH", "3D"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	result := response.GetResult()
+	if result.GetCombinationName() != "Two pair" {
+		t.Errorf("Expected combination Two pair, but got %s", result.GetCombinationName())
+	}
+	if !reflect.DeepEqual(result.GetBestCards(), []string{"KS", "KH", "7D", "7C", "AH"}) {
+		t.Errorf("Expected best cards [KS KH 7D 7C AH], but got %v", result.GetBestCards())
+	}
+	if result.GetDescription() != "Two Pair, Kings and Sevens, Ace kicker" {
+		t.Errorf("Expected description of two pair, but got %s", result.GetDescription())
+	}
+}
+
+func TestEvaluatorServer_Compare(t *testing.T) {
+	client := newClient(t, 1)
+
+	response, err := client.Compare(context.Background(), &pokerpb.CompareRequest{
+		Game:  "holdem",
+		Board: []string{"KS", "QH", "7D", "4C", "2S"},
+		Seats: []*pokerpb.Seat{
+			{Name: "zoe", Cards: []string{"AH", "3D"}},
+			{Name: "bob", Cards: []string{"KH", "8D"}},
+			{Name: "amy", Cards: []string{"KD", "8C"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if response.GetGame() != "holdem" {
+		t.Errorf("Expected game holdem, but got %s", response.GetGame())
+	}
+
+	high := response.GetHigh()
+	var names []string
+	for _, seat := range high.GetSeats() {
+		names = append(names, seat.GetHandName())
+	}
+	if !reflect.DeepEqual(names, []string{"zoe", "bob", "amy"}) {
+		t.Errorf("Expected seats in seat order, but got %v", names)
+	}
+	if !reflect.DeepEqual(high.GetWinners(), []string{"bob", "amy"}) {
+		t.Errorf("Expected winners [bob amy], but got %v", high.GetWinners())
+	}
+	if len(high.GetTies()) != 1 {
+		t.Errorf("Expected one tie, but got %v", high.GetTies())
+	}
+	if response.GetShares()["bob"] != 0.5 || response.GetShares()["amy"] != 0.5 {
+		t.Errorf("Expected split pot, but got %v", response.GetShares())
+	}
+}
+
+func TestEvaluatorServer_InvalidArgument(t *testing.T) {
+	client := newClient(t, 1)
+
+	_, err := client.Compare(context.Background(), &pokerpb.CompareRequest{
+		Game:  "holdem",
+		Board: []string{"KS", "QH", "7D", "4C", "2S"},
+		Seats: []*pokerpb.Seat{
+			{Name: "first", Cards: []string{"AH", "3X"}},
+			{Name: "second", Cards: []string{"KH", "8D"}},
+		},
+	})
+
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("Expected code %s, but got %s", codes.InvalidArgument, st.Code())
+	}
+
+	details := st.Details()
+	if len(details) != 1 {
+		t.Fatalf("Expected one error detail, but got %v", details)
+	}
+	detail, ok := details[0].(*pokerpb.Error)
+	if !ok {
+		t.Fatalf("Expected detail of type pokerpb.Error, but got %T", details[0])
+	}
+	if detail.GetCode() != string(pokererr.CodeInvalidCard) {
+		t.Errorf("Expected error code %s, but got %s", pokererr.CodeInvalidCard, detail.GetCode())
+	}
+}
+
+func TestEvaluatorServer_CompareBatch(t *testing.T) {
+	client := newClient(t, 4)
+
+	stream, err := client.CompareBatch(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	valid := &pokerpb.CompareRequest{
+		Board: []string{"KS", "QH", "7D", "4C", "2S"},
+		Seats: []*pokerpb.Seat{
+			{Name: "first", Cards: []string{"AH", "3D"}},
+			{Name: "second", Cards: []string{"KH", "8D"}},
+		},
+	}
+	invalid := &pokerpb.CompareRequest{
+		Game:  "omaha",
+		Board: []string{"KS", "QH", "7D", "4C", "2S"},
+		Seats: []*pokerpb.Seat{
+			{Name: "first", Cards: []string{"AH", "3D", "5C"}},
+			{Name: "second", Cards: []string{"KH", "8D", "9C", "TC"}},
+		},
+	}
+
+	const deals = 100
+	ids := make([]string, deals)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("deal-%d", i)
+	}
+
+	go func() {
+		for i := 0; i < deals; i++ {
+			deal := valid
+			if i%10 == 3 {
+				deal = invalid
+			}
+			if err := stream.Send(&pokerpb.CompareBatchRequest{Id: ids[i], Deal: deal}); err != nil {
+				return
+			}
+		}
+		_ = stream.CloseSend()
+	}()
+
+	var received int64
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if response.GetIndex() != received {
+			t.Fatalf("Expected index %d, but got %d", received, response.GetIndex())
+		}
+		if response.GetId() != ids[received] {
+			t.Errorf("Expected id %s, but got %s", ids[received], response.GetId())
+		}
+
+		if received%10 == 3 {
+			if response.GetError().GetCode() != string(pokererr.CodeInvalidHoleCardCount) {
+				t.Errorf("Expected error %s for deal %d, but got %v", pokererr.CodeInvalidHoleCardCount, received, response)
+			}
+		} else if !reflect.DeepEqual(response.GetResult().GetHigh().GetWinners(), []string{"second"}) {
+			t.Errorf("Expected winner second for deal %d, but got %v", received, response)
+		}
+
+		received++
+	}
+
+	if received != deals {
+		t.Errorf("Expected %d responses, but got %d", deals, received)
+	}
+}
@@ -0,0 +1,80 @@
+package batch
+
+import (
+	"context"
+)
+
+type job[T, R any] struct {
+	item   T
+	result chan R
+}
+
+// Process reads items with read until it returns false, evaluates them by the given number of workers and writes
+// results in the order of items, while the next items are still being read. At most window items are read ahead of
+// written results, so a slow writer slows down reading instead of growing the memory. pending tells write how many
+// items are already read after this one, e.g. to flush only when there are none.
+//
+// Process returns the first write error or the context error. It doesn't wait for read to return then, read must
+// unblock by itself once the caller returns, like reads of a request body or of a stream.
+func Process[T, R any](
+	ctx context.Context,
+	workers, window int,
+	read func() (T, bool),
+	evaluate func(ctx context.Context, item T) R,
+	write func(result R, pending int) error,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		jobs  = make(chan *job[T, R])
+		queue = make(chan *job[T, R], window)
+	)
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			for j := range jobs {
+				j.result <- evaluate(ctx, j.item)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(queue)
+		defer close(jobs)
+
+		for {
+			item, ok := read()
+			if !ok {
+				return
+			}
+
+			// The job is queued for writing before it's evaluated, so results keep the order of items,
+			// and reading blocks while the queue is full.
+			j := &job[T, R]{item: item, result: make(chan R, 1)}
+			select {
+			case queue <- j:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- j:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for j := range queue {
+		select {
+		case result := <-j.result:
+			if err := write(result, len(queue)); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return ctx.Err()
+}
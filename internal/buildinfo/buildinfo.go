@@ -0,0 +1,55 @@
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// Version, Commit and Time describe the build, they are set with the linker, e.g.
+// go build -ldflags "-X github.com/devandreyl/go-poker-hands-evaluator/internal/buildinfo.Version=v1.2.0".
+// Commit and Time default to the VCS information Go stamps into binaries built from a repository.
+var (
+	Version = "dev"
+	Commit  = ""
+	Time    = ""
+)
+
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit,omitempty"`
+	Time      string `json:"time,omitempty"`
+	Modified  bool   `json:"modified,omitempty"`
+	GoVersion string `json:"goVersion"`
+}
+
+// Read returns the metadata of the running binary.
+func Read() Info {
+	info := Info{
+		Version:   Version,
+		Commit:    Commit,
+		Time:      Time,
+		GoVersion: runtime.Version(),
+	}
+
+	build, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+
+	for _, setting := range build.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			if info.Commit == "" {
+				info.Commit = setting.Value
+			}
+		case "vcs.time":
+			if info.Time == "" {
+				info.Time = setting.Value
+			}
+		case "vcs.modified":
+			info.Modified = setting.Value == "true"
+		}
+	}
+
+	return info
+}
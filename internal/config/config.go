@@ -1,45 +1,280 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
 	"os"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/FZambia/viper-lite"
+	"github.com/go-playground/validator/v10"
 	"github.com/joho/godotenv"
 	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v2"
 )
 
+const (
+	fileFlag  = "config"
+	printFlag = "print-config"
+)
+
+// httpConfig sets the limits of the HTTP server, a zero timeout means no timeout. The read and write timeouts cover
+// the whole request and response, so they cut off long batch streams unless they're long enough.
 type httpConfig struct {
-	Listen string `mapstructure:"listen"`
+	Listen            string        `mapstructure:"listen" validate:"required"`
+	ReadHeaderTimeout time.Duration `mapstructure:"http-read-header-timeout" validate:"gte=0"`
+	ReadTimeout       time.Duration `mapstructure:"http-read-timeout" validate:"gte=0"`
+	WriteTimeout      time.Duration `mapstructure:"http-write-timeout" validate:"gte=0"`
+	IdleTimeout       time.Duration `mapstructure:"http-idle-timeout" validate:"gte=0"`
+	MaxHeaderBytes    int           `mapstructure:"http-max-header-bytes" validate:"gte=0"`
 }
 
+// corsConfig sets what browsers may call the API with, probes and metrics have no CORS headers.
+type corsConfig struct {
+	Origins []string `mapstructure:"cors-origins" validate:"dive,required"`
+	Methods []string `mapstructure:"cors-methods" validate:"dive,required"`
+	Headers []string `mapstructure:"cors-headers" validate:"dive,required"`
+}
+
+// tlsConfig enables TLS of the HTTP and gRPC servers when the certificate and the key files are set. With a non-zero
+// Reload the files are checked for changes at most once per Reload, so renewed certificates are served without
+// a restart.
+type tlsConfig struct {
+	CertFile string        `mapstructure:"tls-cert"`
+	KeyFile  string        `mapstructure:"tls-key"`
+	Reload   time.Duration `mapstructure:"tls-reload" validate:"gte=0"`
+}
+
+type grpcConfig struct {
+	Listen string `mapstructure:"grpc-listen"`
+}
+
+type logConfig struct {
+	Level string `mapstructure:"log-level" validate:"loglevel"`
+}
+
+type batchConfig struct {
+	Workers int `mapstructure:"batch-workers" validate:"gte=0"`
+}
+
+// rateLimitConfig limits the API requests of every client IP address to Rate requests per second on average with
+// bursts of up to Burst requests. Zero Rate disables the limits.
+type rateLimitConfig struct {
+	Rate  float64 `mapstructure:"rate-limit" validate:"gte=0"`
+	Burst int     `mapstructure:"rate-limit-burst" validate:"gte=0"`
+}
+
+// shutdownConfig controls draining: the service reports not ready for Delay, so load balancers stop sending new
+// requests, then in-flight requests are given Timeout to finish before their connections are closed.
+type shutdownConfig struct {
+	Delay   time.Duration `mapstructure:"shutdown-delay" validate:"gte=0"`
+	Timeout time.Duration `mapstructure:"shutdown-timeout" validate:"gte=0"`
+}
+
+// Config is the effective config: flags override env vars, which override the config file, which overrides the
+// defaults. The log level, the CORS options and the rate limits are reloadable, the rest is read at startup only.
 type Config struct {
-	HTTP httpConfig `mapstructure:",squash"`
+	HTTP      httpConfig      `mapstructure:",squash"`
+	CORS      corsConfig      `mapstructure:",squash"`
+	TLS       tlsConfig       `mapstructure:",squash"`
+	GRPC      grpcConfig      `mapstructure:",squash"`
+	Batch     batchConfig     `mapstructure:",squash"`
+	Log       logConfig       `mapstructure:",squash"`
+	RateLimit rateLimitConfig `mapstructure:",squash"`
+	Shutdown  shutdownConfig  `mapstructure:",squash"`
+
+	// File is the YAML, TOML or JSON config file, empty when there's none.
+	File string `mapstructure:"config"`
+	// Print asks to print the config instead of running the service.
+	Print bool `mapstructure:"print-config"`
+
+	commandLine *pflag.FlagSet
 }
 
+var configValidator = newValidator()
+
 func ReadConfig(interspersed bool) (*Config, error) {
-	_ = godotenv.Load() // nolint
+	return readConfig(os.Args[1:], interspersed)
+}
 
+func readConfig(args []string, interspersed bool) (*Config, error) {
 	var commandLine = pflag.NewFlagSet("config", pflag.ContinueOnError)
 	commandLine.SetInterspersed(interspersed)
 
 	_ = commandLine.StringP("listen", "l", ":80", "HTTP binding address")
+	_ = commandLine.Duration("http-read-header-timeout", 10*time.Second, "How long reading request headers may take")
+	_ = commandLine.Duration("http-read-timeout", 0, "How long reading a whole request may take, 0 means no timeout")
+	_ = commandLine.Duration("http-write-timeout", 0, "How long writing a response may take, 0 means no timeout")
+	_ = commandLine.Duration("http-idle-timeout", 2*time.Minute, "How long idle keep-alive connections are kept open")
+	_ = commandLine.Int("http-max-header-bytes", http.DefaultMaxHeaderBytes, "Maximum size of request headers in bytes")
+	_ = commandLine.StringSlice("cors-origins", []string{"http://localhost:3000"}, "Origins allowed to call the API")
+	_ = commandLine.StringSlice("cors-methods", []string{"GET", "POST", "PUT", "DELETE"}, "Methods allowed by CORS")
+	_ = commandLine.StringSlice("cors-headers", []string{"Content-Type"}, "Request headers allowed by CORS")
+	_ = commandLine.String("tls-cert", "", "Certificate file of HTTPS and gRPC, TLS is disabled without it")
+	_ = commandLine.String("tls-key", "", "Private key file of the TLS certificate")
+	_ = commandLine.Duration("tls-reload", 0, "How often the TLS files are checked for changes, 0 disables reloading")
+	_ = commandLine.String("grpc-listen", ":9090", "gRPC binding address, empty disables the gRPC server")
+	_ = commandLine.String("log-level", "info", "Level of JSON logs: debug, info, warn or error")
+	_ = commandLine.Int("batch-workers", 0, "Number of goroutines evaluating one batch request, 0 means the number of CPUs")
+	_ = commandLine.Float64("rate-limit", 0, "API requests per second of one client IP address, 0 disables the limit")
+	_ = commandLine.Int("rate-limit-burst", 20, "API requests one client IP address may send at once")
+	_ = commandLine.Duration("shutdown-delay", 5*time.Second, "How long the service reports not ready before draining")
+	_ = commandLine.Duration("shutdown-timeout", 15*time.Second, "How long in-flight requests are drained on shutdown")
+	_ = commandLine.StringP(fileFlag, "c", "", "YAML, TOML or JSON config file with the keys of the flags")
+	_ = commandLine.Bool(printFlag, false, "Print the effective config as YAML and exit")
+
+	if err := commandLine.Parse(args); err != nil {
+		return nil, err
+	}
+
+	return read(commandLine)
+}
+
+// Reload reads the config again with the command line of the config, so the changes of the config file apply.
+func (c *Config) Reload() (*Config, error) {
+	return read(c.commandLine)
+}
+
+// NeedsRestart tells whether the next config changes settings that are read at startup only.
+func (c *Config) NeedsRestart(next *Config) bool {
+	static := *next
+	static.Log, static.CORS, static.RateLimit = c.Log, c.CORS, c.RateLimit
+
+	return !reflect.DeepEqual(*c, static)
+}
+
+// Write writes the config as YAML with the keys of the flags, so the output can be used as the config file.
+func (c *Config) Write(w io.Writer) error {
+	var settings yaml.MapSlice
+	config := reflect.ValueOf(*c)
+	for i := 0; i < config.NumField(); i++ {
+		section := config.Field(i)
+		if section.Kind() != reflect.Struct {
+			continue
+		}
+
+		for j := 0; j < section.NumField(); j++ {
+			value := section.Field(j).Interface()
+			if duration, ok := value.(time.Duration); ok {
+				value = duration.String()
+			}
+			settings = append(settings, yaml.MapItem{Key: settingName(section.Type().Field(j)), Value: value})
+		}
+	}
 
-	if err := commandLine.Parse(os.Args[1:]); err != nil {
+	out, err := yaml.Marshal(settings)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+
+	return err
+}
+
+func read(commandLine *pflag.FlagSet) (*Config, error) {
+	_ = godotenv.Load() // nolint
+
+	v := viper.New()
+	v.AutomaticEnv()
+	v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+
+	if err := v.BindPFlags(commandLine); err != nil {
 		return nil, err
 	}
 
-	viper.AutomaticEnv()
-	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+	if file := v.GetString(fileFlag); file != "" {
+		if err := readFile(v, commandLine, file); err != nil {
+			return nil, err
+		}
+	}
 
-	if err := viper.BindPFlags(commandLine); err != nil {
+	var config Config
+	if err := v.Unmarshal(&config); err != nil {
 		return nil, err
 	}
+	config.commandLine = commandLine
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// readFile reads the config file into the viper, a key without a flag is an error, so typos don't go unnoticed.
+func readFile(v *viper.Viper, commandLine *pflag.FlagSet, file string) error {
+	fileViper := viper.New()
+	fileViper.SetConfigFile(file)
+	if err := fileViper.ReadInConfig(); err != nil {
+		return fmt.Errorf("config file %s: %w", file, err)
+	}
+
+	for _, key := range fileViper.AllKeys() {
+		if commandLine.Lookup(key) == nil || key == fileFlag || key == printFlag {
+			return fmt.Errorf("config file %s: unknown key %s", file, key)
+		}
+	}
 
-	var (
-		config Config
-		err    = viper.Unmarshal(&config)
-	)
+	v.SetConfigFile(file)
+	if err := v.ReadInConfig(); err != nil {
+		return fmt.Errorf("config file %s: %w", file, err)
+	}
+
+	return nil
+}
+
+func (c *Config) validate() error {
+	var problems []string
+	var validationErrors validator.ValidationErrors
+	if err := configValidator.Struct(c); errors.As(err, &validationErrors) {
+		for _, fieldErr := range validationErrors {
+			problems = append(problems, fmt.Sprintf("%s %s, got %v", fieldErr.Field(), problem(fieldErr), fieldErr.Value()))
+		}
+	} else if err != nil {
+		return err
+	}
+
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		problems = append(problems, "tls-cert and tls-key must be set together")
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid config: %s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+func problem(fieldErr validator.FieldError) string {
+	switch fieldErr.Tag() {
+	case "required":
+		return "must not be empty"
+	case "gte":
+		return "must not be negative"
+	case "loglevel":
+		return "must be debug, info, warn or error"
+	default:
+		return "must pass " + fieldErr.Tag()
+	}
+}
+
+// newValidator returns the validator of the config naming fields by their flags.
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(settingName)
+	_ = v.RegisterValidation("loglevel", func(fl validator.FieldLevel) bool {
+		var level slog.Level
+		return level.UnmarshalText([]byte(strings.TrimSpace(fl.Field().String()))) == nil
+	})
+
+	return v
+}
 
-	return &config, err
+func settingName(field reflect.StructField) string {
+	return strings.Split(field.Tag.Get("mapstructure"), ",")[0]
 }
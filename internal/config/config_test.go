@@ -0,0 +1,207 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	return path
+}
+
+func TestReadConfig_File(t *testing.T) {
+	files := map[string]string{
+		"config.yaml": `
+listen: ":8080"
+log-level: debug
+cors-origins: [https://a.example, https://b.example]
+shutdown-delay: 1s
+`,
+		"config.toml": `
+listen = ":8080"
+log-level = "debug"
+cors-origins = ["https://a.example", "https://b.example"]
+shutdown-delay = "1s"
+`,
+	}
+
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			cnf, err := readConfig([]string{"--config", writeFile(t, name, content)}, true)
+			if err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+
+			if cnf.HTTP.Listen != ":8080" || cnf.Log.Level != "debug" || cnf.Shutdown.Delay != time.Second {
+				t.Errorf("Expected the values of the file, but got %+v", cnf)
+			}
+			if expected := []string{"https://a.example", "https://b.example"}; !reflect.DeepEqual(cnf.CORS.Origins, expected) {
+				t.Errorf("Expected origins %v, but got %v", expected, cnf.CORS.Origins)
+			}
+			if cnf.Shutdown.Timeout != 15*time.Second {
+				t.Errorf("Expected the default shutdown timeout, but got %v", cnf.Shutdown.Timeout)
+			}
+		})
+	}
+}
+
+func TestReadConfig_Precedence(t *testing.T) {
+	file := writeFile(t, "config.yaml", "listen: \":8080\"\nlog-level: debug\nbatch-workers: 2\n")
+	t.Setenv("LOG_LEVEL", "warn")
+	t.Setenv("BATCH_WORKERS", "3")
+
+	cnf, err := readConfig([]string{"--config", file, "--batch-workers", "4"}, true)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if cnf.HTTP.Listen != ":8080" {
+		t.Errorf("Expected the file to override the default, but got %s", cnf.HTTP.Listen)
+	}
+	if cnf.Log.Level != "warn" {
+		t.Errorf("Expected the env var to override the file, but got %s", cnf.Log.Level)
+	}
+	if cnf.Batch.Workers != 4 {
+		t.Errorf("Expected the flag to override the env var, but got %d", cnf.Batch.Workers)
+	}
+}
+
+func TestReadConfig_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		file     string
+		expected []string
+	}{
+		{
+			name:     "Unknown key",
+			file:     "listen: \":8080\"\ncors-origin: https://a.example\n",
+			expected: []string{"unknown key cors-origin"},
+		},
+		{
+			name:     "Nested key",
+			file:     "cors:\n  origins: [https://a.example]\n",
+			expected: []string{"unknown key cors.origins"},
+		},
+		{
+			name:     "Command line only key",
+			file:     "print-config: true\n",
+			expected: []string{"unknown key print-config"},
+		},
+		{
+			name:     "Malformed file",
+			file:     "listen: [\n",
+			expected: []string{"config file"},
+		},
+		{
+			name: "Invalid values",
+			args: []string{"--log-level", "verbose", "--batch-workers", "-1", "--tls-cert", "cert.pem", "--listen", ""},
+			expected: []string{
+				`log-level must be debug, info, warn or error, got verbose`,
+				"batch-workers must not be negative, got -1",
+				"listen must not be empty",
+				"tls-cert and tls-key must be set together",
+			},
+		},
+		{
+			name:     "Missing file",
+			args:     []string{"--config", "missing.yaml"},
+			expected: []string{"config file missing.yaml"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := tt.args
+			if tt.file != "" {
+				args = append(args, "--config", writeFile(t, "config.yaml", tt.file))
+			}
+
+			_, err := readConfig(args, true)
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			for _, expected := range tt.expected {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("Expected %q in the error %q", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestConfig_Reload(t *testing.T) {
+	file := writeFile(t, "config.yaml", "log-level: info\nrate-limit: 5\n")
+
+	cnf, err := readConfig([]string{"--config", file, "--cors-origins", "https://a.example"}, true)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if err := os.WriteFile(file, []byte("log-level: debug\nrate-limit: 10\ncors-origins: [https://b.example]\n"), 0o600); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	next, err := cnf.Reload()
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if next.Log.Level != "debug" || next.RateLimit.Rate != 10 {
+		t.Errorf("Expected the changes of the file, but got %+v", next)
+	}
+	if !reflect.DeepEqual(next.CORS.Origins, []string{"https://a.example"}) {
+		t.Errorf("Expected the flag to keep overriding the file, but got %v", next.CORS.Origins)
+	}
+	if cnf.NeedsRestart(next) {
+		t.Error("Expected reloadable changes not to need a restart")
+	}
+
+	if err := os.WriteFile(file, []byte("listen: \":8080\"\n"), 0o600); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if next, err = cnf.Reload(); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if !cnf.NeedsRestart(next) {
+		t.Error("Expected the listen address change to need a restart")
+	}
+}
+
+func TestConfig_Write(t *testing.T) {
+	cnf, err := readConfig([]string{"--listen", ":8080", "--cors-origins", "https://a.example,https://b.example"}, true)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := cnf.Write(&out); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	for _, line := range []string{"listen: :8080", "- https://b.example", "shutdown-delay: 5s", "rate-limit-burst: 20"} {
+		if !strings.Contains(out.String(), line) {
+			t.Errorf("Expected %q in the output:\n%s", line, out.String())
+		}
+	}
+
+	// The output is a valid config file with the same config.
+	written, err := readConfig([]string{"--config", writeFile(t, "config.yaml", out.String())}, true)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	written.File, written.commandLine = cnf.File, cnf.commandLine
+	if !reflect.DeepEqual(written, cnf) {
+		t.Errorf("Expected the written config %+v, but got %+v", cnf, written)
+	}
+}
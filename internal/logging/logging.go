@@ -0,0 +1,52 @@
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/middleware"
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+// level is shared by all loggers, so it can be changed while the service is running.
+var level = new(slog.LevelVar)
+
+// New returns a logger writing JSON lines at the current level. Records logged with a context carry
+// the request ID of the context.
+func New(w io.Writer) *slog.Logger {
+	return slog.New(contextHandler{Handler: slog.NewJSONHandler(w, &slog.HandlerOptions{Level: level})})
+}
+
+// SetLevel sets the level of all loggers by its name: debug, info, warn or error.
+func SetLevel(name string) error {
+	var parsed slog.Level
+	if err := parsed.UnmarshalText([]byte(strings.TrimSpace(name))); err != nil {
+		return pokererr.Wrap(err, pokererr.CodeValidationError, pokererr.Data{"log-level": name})
+	}
+	level.Set(parsed)
+
+	return nil
+}
+
+// contextHandler adds the request ID of the context to every record.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id := middleware.RequestIDFromContext(ctx); id != "" {
+		record.AddAttrs(slog.String("request_id", id))
+	}
+
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{Handler: h.Handler.WithGroup(name)}
+}
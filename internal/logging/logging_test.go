@@ -0,0 +1,71 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/middleware"
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+func TestSetLevel(t *testing.T) {
+	defer level.Set(slog.LevelInfo)
+
+	tests := []struct {
+		name     string
+		expected slog.Level
+		valid    bool
+	}{
+		{name: "debug", expected: slog.LevelDebug, valid: true},
+		{name: "WARN", expected: slog.LevelWarn, valid: true},
+		{name: " error ", expected: slog.LevelError, valid: true},
+		{name: "verbose", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SetLevel(tt.name)
+			if !tt.valid {
+				var pokerError *pokererr.Error
+				if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeValidationError {
+					t.Errorf("Expected validation error, but got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+			if level.Level() != tt.expected {
+				t.Errorf("Expected level %s, but got %s", tt.expected, level.Level())
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	defer level.Set(slog.LevelInfo)
+	if err := SetLevel("info"); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	var logs bytes.Buffer
+	logger := New(&logs)
+
+	ctx := middleware.WithRequestID(context.Background(), "req-42")
+	logger.DebugContext(ctx, "hidden")
+	logger.With(slog.String("component", "test")).InfoContext(ctx, "shown")
+
+	var line map[string]any
+	if err := json.Unmarshal(logs.Bytes(), &line); err != nil {
+		t.Fatalf("Unexpected error %v in %s", err, logs.String())
+	}
+
+	if line["msg"] != "shown" || line["request_id"] != "req-42" || line["component"] != "test" {
+		t.Errorf("Unexpected log line %s", logs.String())
+	}
+}
@@ -0,0 +1,111 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/devandreyl/go-poker-hands-evaluator/internal/middleware"
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+	"github.com/devandreyl/go-poker-hands-evaluator/pkg/holdem"
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "poker"
+
+// Registry holds the metrics of the service together with the Go runtime and process metrics.
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "HTTP requests by route template, method and status.",
+	}, []string{"route", "method", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of HTTP requests by route template, method and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method", "status"})
+
+	evaluations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "evaluations_total",
+		Help:      "Evaluated hands by game and combination category, low hands of split pot games included.",
+	}, []string{"game", "category"})
+
+	validationErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "validation_errors_total",
+		Help:      "Requests and batch deals rejected because of the client input, by error code.",
+	}, []string{"code"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpDuration,
+		evaluations,
+		validationErrors,
+	)
+}
+
+// Handler serves the metrics in the Prometheus format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+// Middleware counts requests and observes their latency. It's a mux middleware, so requests are labeled by the
+// template of the matched route, e.g. /v1/evaluate-hand, and paths that match no route don't grow the label set.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := "unknown"
+		if current := mux.CurrentRoute(r); current != nil {
+			if template, err := current.GetPathTemplate(); err == nil {
+				route = template
+			}
+		}
+
+		recorder := middleware.NewResponseRecorder(w)
+		start := time.Now()
+		next.ServeHTTP(recorder, r)
+
+		status := strconv.Itoa(recorder.Status)
+		httpRequests.WithLabelValues(route, r.Method, status).Inc()
+		httpDuration.WithLabelValues(route, r.Method, status).Observe(time.Since(start).Seconds())
+	})
+}
+
+// ObserveGameResult counts the hands of the result of the game, low hands of split pot games included.
+func ObserveGameResult(result *holdem.GameResult) {
+	ObserveEvaluateResult(result.Game, result.EvaluateResult)
+	ObserveEvaluateResult(result.Game, result.Low)
+}
+
+// ObserveEvaluateResult counts the hands of the result, the result is ignored when it's nil.
+func ObserveEvaluateResult(game holdem.Game, result *holdem.EvaluateResult) {
+	if result == nil {
+		return
+	}
+
+	for _, handResult := range result.Seats {
+		evaluations.WithLabelValues(string(game), handResult.Category()).Inc()
+	}
+}
+
+// ObserveError counts the error when it's caused by the client input. Rate limited requests aren't invalid input,
+// they are counted by the 429 status of the HTTP requests.
+func ObserveError(err *pokererr.Error) {
+	if err != nil && err.Code.IsClientError() && err.Code != pokererr.CodeApiRateLimited {
+		validationErrors.WithLabelValues(string(err.Code)).Inc()
+	}
+}
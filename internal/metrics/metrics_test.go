@@ -0,0 +1,111 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+	"github.com/devandreyl/go-poker-hands-evaluator/pkg/holdem"
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMiddleware(t *testing.T) {
+	router := mux.NewRouter()
+	router.Use(Middleware)
+	router.HandleFunc("/items/{id}", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+		// Streaming handlers flush through the recorder.
+		if err := http.NewResponseController(w).Flush(); err != nil {
+			t.Errorf("Unexpected error %v", err)
+		}
+	})
+	router.HandleFunc("/ok", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	requests := []string{"/items/1", "/items/2", "/ok"}
+	for _, path := range requests {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	tests := []struct {
+		route    string
+		status   string
+		expected float64
+	}{
+		{route: "/items/{id}", status: "418", expected: 2},
+		{route: "/ok", status: "200", expected: 1},
+	}
+
+	for _, tt := range tests {
+		if got := testutil.ToFloat64(httpRequests.WithLabelValues(tt.route, http.MethodGet, tt.status)); got != tt.expected {
+			t.Errorf("Expected %v requests of %s, but got %v", tt.expected, tt.route, got)
+		}
+	}
+	if count := testutil.CollectAndCount(httpDuration); count != len(tests) {
+		t.Errorf("Expected latency of %d routes, but got %d", len(tests), count)
+	}
+}
+
+func TestObserveGameResult(t *testing.T) {
+	result, err := holdem.EvaluateGameSeats(holdem.GameOmahaHiLo, []string{"2H", "5H", "9H", "KD", "7C"}, holdem.Seats{
+		{Name: "first", Cards: []string{"AH", "3C", "KS", "KC"}},
+		{Name: "second", Cards: []string{"AD", "4C", "QS", "QC"}},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	ObserveGameResult(result)
+
+	tests := []struct {
+		category string
+		expected float64
+	}{
+		{category: "Three of a kind", expected: 1},
+		{category: "Pair", expected: 1},
+		{category: holdem.LowCategory, expected: 2},
+	}
+
+	for _, tt := range tests {
+		got := testutil.ToFloat64(evaluations.WithLabelValues(string(holdem.GameOmahaHiLo), tt.category))
+		if got != tt.expected {
+			t.Errorf("Expected %v evaluations of %s, but got %v", tt.expected, tt.category, got)
+		}
+	}
+}
+
+func TestObserveError(t *testing.T) {
+	ObserveError(pokererr.NewError(pokererr.CodeInvalidCard, nil))
+	ObserveError(pokererr.NewError(pokererr.CodeInvalidCard, nil))
+	ObserveError(pokererr.NewError(pokererr.CodeUnknown, nil))
+	ObserveError(pokererr.NewError(pokererr.CodeApiRateLimited, nil))
+	ObserveError(nil)
+
+	if got := testutil.ToFloat64(validationErrors.WithLabelValues(string(pokererr.CodeInvalidCard))); got != 2 {
+		t.Errorf("Expected 2 errors, but got %v", got)
+	}
+	if count := testutil.CollectAndCount(validationErrors); count != 1 {
+		t.Errorf("Expected only client input errors to be counted, but got %d codes", count)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	ObserveError(pokererr.NewError(pokererr.CodeDuplicateCard, nil))
+
+	recorder := httptest.NewRecorder()
+	Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	body := recorder.Body.String()
+	for _, metric := range []string{
+		`poker_validation_errors_total{code="holdem.card.duplicate"} 1`,
+		"go_goroutines",
+	} {
+		if !strings.Contains(body, metric) {
+			t.Errorf("Expected %s in the metrics", metric)
+		}
+	}
+}
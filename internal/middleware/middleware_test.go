@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name      string
+		header    string
+		generated bool
+	}{
+		{name: "Accepted", header: "req-42"},
+		{name: "Missing", header: "", generated: true},
+		{name: "Forged log line", header: "id\n{\"level\":\"ERROR\"}", generated: true},
+		{name: "Too long", header: strings.Repeat("a", maxRequestIDLength+1), generated: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fromContext string
+			handler := RequestID(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				fromContext = RequestIDFromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(HeaderRequestID, tt.header)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			id := recorder.Header().Get(HeaderRequestID)
+			if id != fromContext {
+				t.Errorf("Expected the same request ID in the context and the response, but got %s and %s", fromContext, id)
+			}
+			if tt.generated && (id == tt.header || len(id) != 32) {
+				t.Errorf("Expected a generated request ID, but got %q", id)
+			}
+			if !tt.generated && id != tt.header {
+				t.Errorf("Expected request ID %s, but got %s", tt.header, id)
+			}
+		})
+	}
+}
+
+func TestAccessLog(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, nil))
+
+	handler := AccessLog(logger)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte("accepted"))
+		if err := http.NewResponseController(w).Flush(); err != nil {
+			t.Errorf("Unexpected error %v", err)
+		}
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/v1/evaluate-hand", nil))
+
+	var line struct {
+		Msg    string `json:"msg"`
+		Method string `json:"method"`
+		Path   string `json:"path"`
+		Status int    `json:"status"`
+		Bytes  int64  `json:"bytes"`
+	}
+	if err := json.Unmarshal(logs.Bytes(), &line); err != nil {
+		t.Fatalf("Unexpected error %v in %s", err, logs.String())
+	}
+
+	if line.Msg != "request" || line.Method != http.MethodPost || line.Path != "/v1/evaluate-hand" ||
+		line.Status != http.StatusAccepted || line.Bytes != int64(len("accepted")) {
+		t.Errorf("Unexpected access log %s", logs.String())
+	}
+}
@@ -0,0 +1,91 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often the buckets of clients that didn't send requests long enough to refill are dropped.
+const sweepInterval = time.Minute
+
+// Limiter limits requests of every client with a token bucket: a client may send burst requests at once and then
+// rate requests per second on average. The limits can be changed while serving, zero rate disables them.
+type Limiter struct {
+	mu      sync.Mutex
+	rate    float64
+	burst   float64
+	clients map[string]*bucket
+	swept   time.Time
+	now     func() time.Time
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+func New(rate float64, burst int) *Limiter {
+	l := &Limiter{
+		clients: make(map[string]*bucket),
+		now:     time.Now,
+	}
+	l.SetLimit(rate, burst)
+
+	return l
+}
+
+// SetLimit changes the limits, clients keep their tokens up to the new burst. A burst below one allows one request.
+func (l *Limiter) SetLimit(rate float64, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rate = rate
+	l.burst = math.Max(float64(burst), 1)
+	for _, b := range l.clients {
+		b.tokens = math.Min(b.tokens, l.burst)
+	}
+}
+
+// Allow takes a token of the client and tells whether there was one, and if not, how long until the next token.
+// Buckets of idle clients are swept at most once per minute.
+func (l *Limiter) Allow(client string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate <= 0 {
+		return true, 0
+	}
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.clients[client]
+	if !ok {
+		b = &bucket{tokens: l.burst, updated: now}
+		l.clients[client] = b
+	}
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.updated).Seconds()*l.rate)
+	b.updated = now
+
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+	}
+	b.tokens--
+
+	return true, 0
+}
+
+// sweep drops the buckets that are full again, such clients start over with a full bucket anyway.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.swept) < sweepInterval {
+		return
+	}
+	l.swept = now
+
+	for client, b := range l.clients {
+		if b.tokens+now.Sub(b.updated).Seconds()*l.rate >= l.burst {
+			delete(l.clients, client)
+		}
+	}
+}
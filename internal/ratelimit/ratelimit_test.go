@@ -0,0 +1,84 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestLimiter(rate float64, burst int) (*Limiter, *time.Time) {
+	now := time.Now()
+	l := New(rate, burst)
+	l.now = func() time.Time {
+		return now
+	}
+
+	return l, &now
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	l, now := newTestLimiter(2, 3)
+
+	for i := 0; i < 3; i++ {
+		if ok, _ := l.Allow("a"); !ok {
+			t.Fatalf("Expected request %d of the burst to be allowed", i)
+		}
+	}
+
+	ok, retryAfter := l.Allow("a")
+	if ok || retryAfter != 500*time.Millisecond {
+		t.Errorf("Expected a rejection with a retry after 500ms, but got %t and %v", ok, retryAfter)
+	}
+	if ok, _ := l.Allow("b"); !ok {
+		t.Error("Expected another client to have its own bucket")
+	}
+
+	*now = now.Add(500 * time.Millisecond)
+	if ok, _ := l.Allow("a"); !ok {
+		t.Error("Expected a refilled token to be allowed")
+	}
+	if ok, _ := l.Allow("a"); ok {
+		t.Error("Expected the bucket to be empty again")
+	}
+}
+
+func TestLimiter_SetLimit(t *testing.T) {
+	l, now := newTestLimiter(1, 5)
+	for i := 0; i < 5; i++ {
+		l.Allow("a")
+	}
+
+	l.SetLimit(0, 0)
+	for i := 0; i < 10; i++ {
+		if ok, _ := l.Allow("a"); !ok {
+			t.Fatal("Expected zero rate to disable the limit")
+		}
+	}
+
+	l.SetLimit(1, 2)
+	*now = now.Add(time.Hour)
+	for i := 0; i < 2; i++ {
+		if ok, _ := l.Allow("a"); !ok {
+			t.Fatalf("Expected request %d of the new burst to be allowed", i)
+		}
+	}
+	if ok, _ := l.Allow("a"); ok {
+		t.Error("Expected the new burst to limit the bucket")
+	}
+}
+
+func TestLimiter_Sweep(t *testing.T) {
+	l, now := newTestLimiter(1, 2)
+	l.Allow("a")
+	l.Allow("b")
+	l.Allow("b")
+
+	*now = now.Add(sweepInterval)
+	l.Allow("c")
+
+	if _, ok := l.clients["a"]; ok {
+		t.Error("Expected the refilled bucket to be dropped")
+	}
+	if len(l.clients) != 1 {
+		t.Errorf("Expected only the bucket of the new client, but got %d buckets", len(l.clients))
+	}
+}
@@ -0,0 +1,117 @@
+package tlscert
+
+import (
+	"crypto/tls"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Reloader serves the certificate of a certificate and key file pair. With a non-zero interval the files are checked
+// for changes on handshakes at most once per interval and loaded again when they change, so renewed certificates
+// are served without a restart. When the changed files don't load, e.g. the key isn't written yet, the previous
+// certificate is served and the files are checked again after the interval.
+type Reloader struct {
+	certFile string
+	keyFile  string
+	interval time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	modTime time.Time
+	checked time.Time
+}
+
+// NewReloader loads the certificate or returns the error of loading it.
+func NewReloader(certFile, keyFile string, interval time.Duration) (*Reloader, error) {
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		interval: interval,
+		now:      time.Now,
+	}
+
+	modTime, err := r.filesModTime()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.load(modTime); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// TLSConfig returns the server TLS config serving the certificate of the reloader.
+func (r *Reloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: r.GetCertificate,
+	}
+}
+
+// GetCertificate is the tls.Config hook returning the current certificate.
+func (r *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.interval > 0 && r.now().Sub(r.checked) >= r.interval {
+		if err := r.reload(); err != nil {
+			slog.Warn("reload tls certificate", slog.String("error", err.Error()), slog.String("cert", r.certFile))
+		}
+	}
+
+	return r.cert, nil
+}
+
+func (r *Reloader) reload() error {
+	r.checked = r.now()
+
+	modTime, err := r.filesModTime()
+	if err != nil {
+		return err
+	}
+	if !modTime.After(r.modTime) {
+		return nil
+	}
+
+	if err := r.load(modTime); err != nil {
+		return err
+	}
+	slog.Info("tls certificate reloaded", slog.String("cert", r.certFile))
+
+	return nil
+}
+
+func (r *Reloader) load(modTime time.Time) error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return errors.Wrap(err, "load tls certificate")
+	}
+
+	r.cert = &cert
+	r.modTime = modTime
+	r.checked = r.now()
+
+	return nil
+}
+
+// filesModTime returns the latest modification time of the certificate and the key files.
+func (r *Reloader) filesModTime() (time.Time, error) {
+	var latest time.Time
+	for _, name := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(name)
+		if err != nil {
+			return time.Time{}, errors.Wrap(err, "load tls certificate")
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+
+	return latest, nil
+}
@@ -0,0 +1,171 @@
+package tlscert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCertificate writes a self-signed certificate of the common name and its key with the modification time.
+func writeCertificate(t *testing.T, certFile, keyFile, commonName string, modTime time.Time) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	files := map[string]*pem.Block{
+		certFile: {Type: "CERTIFICATE", Bytes: der},
+		keyFile:  {Type: "EC PRIVATE KEY", Bytes: keyDer},
+	}
+	for name, block := range files {
+		if err := os.WriteFile(name, pem.EncodeToMemory(block), 0o600); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		if err := os.Chtimes(name, modTime, modTime); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+	}
+}
+
+func commonName(t *testing.T, r *Reloader) string {
+	t.Helper()
+
+	cert, err := r.GetCertificate(&tls.ClientHelloInfo{})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	return leaf.Subject.CommonName
+}
+
+func TestNewReloader_Error(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+
+	if _, err := NewReloader(certFile, keyFile, 0); err == nil {
+		t.Error("Expected an error of missing files")
+	}
+
+	writeCertificate(t, certFile, keyFile, "first", time.Now())
+	if err := os.WriteFile(keyFile, []byte("not a key"), 0o600); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if _, err := NewReloader(certFile, keyFile, 0); err == nil {
+		t.Error("Expected an error of the invalid key")
+	}
+}
+
+func TestReloader_GetCertificate(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	start := time.Now().Add(-time.Hour)
+	writeCertificate(t, certFile, keyFile, "first", start)
+
+	tests := []struct {
+		name     string
+		interval time.Duration
+		// update writes the files before the handshake, elapsed after the reloader was created.
+		update   func()
+		elapsed  time.Duration
+		expected string
+	}{
+		{
+			name:     "Unchanged",
+			interval: time.Minute,
+			elapsed:  time.Hour,
+			expected: "first",
+		},
+		{
+			name:     "Renewed",
+			interval: time.Minute,
+			update: func() {
+				writeCertificate(t, certFile, keyFile, "second", start.Add(time.Minute))
+			},
+			elapsed:  time.Minute,
+			expected: "second",
+		},
+		{
+			name:     "Renewed within the interval",
+			interval: time.Minute,
+			update: func() {
+				writeCertificate(t, certFile, keyFile, "second", start.Add(time.Minute))
+			},
+			elapsed:  time.Second,
+			expected: "first",
+		},
+		{
+			name:     "Reloading disabled",
+			interval: 0,
+			update: func() {
+				writeCertificate(t, certFile, keyFile, "second", start.Add(time.Minute))
+			},
+			elapsed:  time.Hour,
+			expected: "first",
+		},
+		{
+			name:     "Invalid renewal",
+			interval: time.Minute,
+			update: func() {
+				if err := os.WriteFile(certFile, []byte("partially written"), 0o600); err != nil {
+					t.Fatalf("Unexpected error %v", err)
+				}
+			},
+			elapsed:  time.Hour,
+			expected: "first",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeCertificate(t, certFile, keyFile, "first", start)
+			r, err := NewReloader(certFile, keyFile, tt.interval)
+			if err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+
+			now := time.Now()
+			r.now = func() time.Time {
+				return now
+			}
+			r.checked = now
+
+			if tt.update != nil {
+				tt.update()
+			}
+			now = now.Add(tt.elapsed)
+
+			if got := commonName(t, r); got != tt.expected {
+				t.Errorf("Expected the certificate %s, but got %s", tt.expected, got)
+			}
+		})
+	}
+}
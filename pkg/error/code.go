@@ -1,5 +1,10 @@
 package pokererr
 
+import (
+	"net/http"
+	"sort"
+)
+
 type Code string
 
 const (
@@ -7,4 +12,164 @@ const (
 	CodeUnknown         Code = "unknown"
 	CodeValidationError Code = "failed_validation_request"
 	CodeApiDecoderError Code = "api.decoder.error"
+	CodeApiLineTooLong  Code = "api.batch.line_too_long"
+	CodeApiRateLimited  Code = "api.rate_limit.exceeded"
+
+	CodeInvalidBoardSize      Code = "holdem.board.invalid_size"
+	CodeInvalidHoleCardCount  Code = "holdem.hole_cards.invalid_count"
+	CodeInvalidCard           Code = "holdem.card.invalid"
+	CodeDuplicateCard         Code = "holdem.card.duplicate"
+	CodeNotEnoughCards        Code = "holdem.deck.not_enough_cards"
+	CodeNotEnoughHands        Code = "holdem.hands.not_enough"
+	CodeInvalidIterations     Code = "holdem.equity.invalid_iterations"
+	CodeInvalidRange          Code = "holdem.range.invalid"
+	CodeEmptyRange            Code = "holdem.range.empty"
+	CodeUnsupportedGame       Code = "holdem.game.unsupported"
+	CodeGameAlreadyRegistered Code = "holdem.game.already_registered"
+	CodeInvalidPot            Code = "holdem.pot.invalid"
+	CodeInvalidSeat           Code = "holdem.seat.invalid"
 )
+
+// CodeInfo is the entry of a code in the error catalog. Codes, statuses and titles are stable within a major version,
+// descriptions may be reworded.
+type CodeInfo struct {
+	Code Code `json:"code"`
+	// Status is the HTTP status of responses with the error.
+	Status      int    `json:"status"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// catalog describes every code, codes missing from it are treated as unknown server errors.
+var catalog = map[Code]CodeInfo{
+	CodeGeneralError: {
+		Status:      http.StatusInternalServerError,
+		Title:       "General error",
+		Description: "An error that isn't a poker error caused another error, data.message has its text.",
+	},
+	CodeUnknown: {
+		Status:      http.StatusInternalServerError,
+		Title:       "Unknown error",
+		Description: "An unexpected error of the service.",
+	},
+	CodeValidationError: {
+		Status:      http.StatusBadRequest,
+		Title:       "Invalid request",
+		Description: "Fields of the request are missing or invalid, data has the reason of every invalid field.",
+	},
+	CodeApiDecoderError: {
+		Status:      http.StatusBadRequest,
+		Title:       "Malformed request",
+		Description: "The request body isn't valid JSON or its fields have wrong types.",
+	},
+	CodeApiLineTooLong: {
+		Status:      http.StatusRequestEntityTooLarge,
+		Title:       "Batch line too long",
+		Description: "A deal line of a batch stream is longer than the limit, the stream ends with this error.",
+	},
+	CodeApiRateLimited: {
+		Status:      http.StatusTooManyRequests,
+		Title:       "Rate limit exceeded",
+		Description: "The client sent more requests than the rate limit allows, data.retryAfter is the seconds to wait.",
+	},
+	CodeInvalidBoardSize: {
+		Status:      http.StatusBadRequest,
+		Title:       "Invalid board size",
+		Description: "The board has a number of cards the game doesn't deal.",
+	},
+	CodeInvalidHoleCardCount: {
+		Status:      http.StatusBadRequest,
+		Title:       "Wrong card count",
+		Description: "A hand has a number of cards the game doesn't deal.",
+	},
+	CodeInvalidCard: {
+		Status:      http.StatusBadRequest,
+		Title:       "Invalid card",
+		Description: "A card isn't a rank and a suit, e.g. AH or TD, or a joker in a game without jokers.",
+	},
+	CodeDuplicateCard: {
+		Status:      http.StatusBadRequest,
+		Title:       "Duplicate card",
+		Description: "The same card is dealt more than once, data lists where each card was found.",
+	},
+	CodeNotEnoughCards: {
+		Status:      http.StatusBadRequest,
+		Title:       "Not enough cards",
+		Description: "The deal needs more cards than the deck has: two for every hand, five for the board and the dead cards.",
+	},
+	CodeNotEnoughHands: {
+		Status:      http.StatusBadRequest,
+		Title:       "Not enough hands",
+		Description: "There are fewer hands than the calculation needs.",
+	},
+	CodeInvalidIterations: {
+		Status:      http.StatusBadRequest,
+		Title:       "Invalid iterations",
+		Description: "The number of random boards is out of the allowed range, or exhaustive enumeration has too many boards.",
+	},
+	CodeInvalidRange: {
+		Status:      http.StatusBadRequest,
+		Title:       "Invalid range",
+		Description: "A hand range isn't in the range notation, e.g. AKs, QQ+ or 76s-54s.",
+	},
+	CodeEmptyRange: {
+		Status:      http.StatusBadRequest,
+		Title:       "Empty range",
+		Description: "A hand range has no combinations left after removing the dealt cards.",
+	},
+	CodeUnsupportedGame: {
+		Status:      http.StatusBadRequest,
+		Title:       "Unsupported game",
+		Description: "The game isn't supported, GET /v1/games lists the supported games.",
+	},
+	CodeGameAlreadyRegistered: {
+		Status:      http.StatusInternalServerError,
+		Title:       "Game already registered",
+		Description: "A game is registered twice with the Go package, it's not caused by a request.",
+	},
+	CodeInvalidPot: {
+		Status:      http.StatusBadRequest,
+		Title:       "Invalid pot",
+		Description: "The pot to split is negative.",
+	},
+	CodeInvalidSeat: {
+		Status:      http.StatusBadRequest,
+		Title:       "Invalid seat",
+		Description: "A seat has the same name as a previous seat, results refer to hands by name.",
+	},
+}
+
+// Catalog returns the entries of all codes sorted by code.
+func Catalog() []CodeInfo {
+	entries := make([]CodeInfo, 0, len(catalog))
+	for code := range catalog {
+		entries = append(entries, code.Info())
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Code < entries[j].Code
+	})
+
+	return entries
+}
+
+// Info returns the catalog entry of the code, the entry of CodeUnknown with the code for codes out of the catalog.
+func (c Code) Info() CodeInfo {
+	info, ok := catalog[c]
+	if !ok {
+		info = catalog[CodeUnknown]
+	}
+	info.Code = c
+
+	return info
+}
+
+// Status returns the HTTP status of responses with errors of the code.
+func (c Code) Status() int {
+	return c.Info().Status
+}
+
+// IsClientError tells whether errors with the code are caused by the client, e.g. an invalid card.
+func (c Code) IsClientError() bool {
+	status := c.Status()
+	return status >= http.StatusBadRequest && status < http.StatusInternalServerError
+}
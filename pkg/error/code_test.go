@@ -0,0 +1,47 @@
+package pokererr
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestCode_Status(t *testing.T) {
+	tests := []struct {
+		code   Code
+		status int
+		client bool
+	}{
+		{code: CodeInvalidCard, status: http.StatusBadRequest, client: true},
+		{code: CodeApiDecoderError, status: http.StatusBadRequest, client: true},
+		{code: CodeApiRateLimited, status: http.StatusTooManyRequests, client: true},
+		{code: CodeGameAlreadyRegistered, status: http.StatusInternalServerError},
+		{code: "not.in.catalog", status: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.code), func(t *testing.T) {
+			if status := tt.code.Status(); status != tt.status {
+				t.Errorf("Expected status %d, but got %d", tt.status, status)
+			}
+			if client := tt.code.IsClientError(); client != tt.client {
+				t.Errorf("Expected client error %t, but got %t", tt.client, client)
+			}
+		})
+	}
+}
+
+func TestCatalog(t *testing.T) {
+	entries := Catalog()
+	if len(entries) != len(catalog) {
+		t.Fatalf("Expected %d entries, but got %d", len(catalog), len(entries))
+	}
+
+	for i, entry := range entries {
+		if entry.Title == "" || entry.Description == "" || entry.Status == 0 {
+			t.Errorf("Expected the entry of %s to be complete, but got %+v", entry.Code, entry)
+		}
+		if i > 0 && entries[i-1].Code >= entry.Code {
+			t.Errorf("Expected entries sorted by code, but got %s before %s", entries[i-1].Code, entry.Code)
+		}
+	}
+}
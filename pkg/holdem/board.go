@@ -0,0 +1,93 @@
+package holdem
+
+import (
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+const (
+	combinationCardsCount = 5
+	holdemHoleCardsCount  = 2
+	minBoardCardsCount    = 3
+	maxBoardCardsCount    = 5
+)
+
+// EvaluateHoldemHands evaluates Texas Hold'em hands. Every hand holds two hole cards and shares the board of three to five
+// community cards, each hand plays the best five cards out of its hole cards and the board.
+func EvaluateHoldemHands(board []string, hands Hands) (*EvaluateResult, error) {
+	seats := hands.Seats()
+	if err := validateBoardDeal(board, seats, holdemHoleCardsCount); err != nil {
+		return nil, err
+	}
+
+	boardCards, handsWithCards, err := parseDeal(board, seats)
+	if err != nil {
+		return nil, err
+	}
+
+	handCombinations := make([]*HandResult, 0, len(handsWithCards))
+
+	for _, hand := range handsWithCards {
+		hand.Cards = append(hand.Cards, boardCards...)
+		handCombinations = append(handCombinations, hand.BestCombination())
+	}
+
+	return newEvaluateResult(handCombinations), nil
+}
+
+// validateBoardDeal checks that the board has three to five cards and every hand has the given number of hole cards.
+func validateBoardDeal(board []string, seats Seats, holeCardsCount int) error {
+	if len(board) < minBoardCardsCount || len(board) > maxBoardCardsCount {
+		return pokererr.NewError(pokererr.CodeInvalidBoardSize, pokererr.Data{
+			"count": len(board),
+			"min":   minBoardCardsCount,
+			"max":   maxBoardCardsCount,
+		})
+	}
+
+	for _, seat := range seats {
+		if len(seat.Cards) != holeCardsCount {
+			return pokererr.NewError(pokererr.CodeInvalidHoleCardCount, pokererr.Data{
+				"hand":  seat.Name,
+				"count": len(seat.Cards),
+				"min":   holeCardsCount,
+				"max":   holeCardsCount,
+			})
+		}
+	}
+
+	return nil
+}
+
+// BestCombination Complexity: O(C(n, 5)) (binomial)
+// Every five-card subset of the hand is evaluated by DefineCombination and the one with the highest rank is kept,
+// for seven cards it's 21 subsets. The cards that formed the combination are reported in BestCards.
+func (h *Hand) BestCombination() *HandResult {
+	return h.bestOf((*Hand).DefineCombination)
+}
+
+// bestOf evaluates every five-card subset of the hand by the given rules and keeps the result with the highest rank.
+func (h *Hand) bestOf(define func(h *Hand) *HandResult) *HandResult {
+	var best *HandResult
+
+	size := combinationCardsCount
+	if len(h.Cards) < size {
+		size = len(h.Cards)
+	}
+
+	forEachCombination(len(h.Cards), size, func(indexes []int) {
+		candidate := Hand{
+			Name:  h.Name,
+			Cards: pickCards(h.Cards, indexes),
+		}
+
+		result := define(&candidate)
+		if result != nil && (best == nil || result.Rank > best.Rank) {
+			if result.BestCards == nil {
+				result.BestCards = cardStrings(candidate.Cards)
+			}
+			best = result
+		}
+	})
+
+	return best
+}
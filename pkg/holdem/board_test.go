@@ -0,0 +1,142 @@
+package holdem
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+func TestEvaluateHoldemHands(t *testing.T) {
+	tests := []struct {
+		name              string
+		board             []string
+		hands             Hands
+		expectedWinners   []string
+		expectedBestCards map[string][]string
+		expectedNames     map[string]string
+	}{
+		{
+			name:  "Full board",
+			board: []string{"AS", "KD", "7C", "7H", "2S"},
+			hands: Hands{
+				"first":  {"AH", "3C"},
+				"second": {"7D", "QC"},
+			},
+			expectedWinners: []string{"second"},
+			expectedBestCards: map[string][]string{
+				"first":  {"AS", "KD", "7C", "7H", "AH"},
+				"second": {"AS", "KD", "7C", "7H", "7D"},
+			},
+			expectedNames: map[string]string{
+				"first":  "Two pair",
+				"second": "Three of a kind",
+			},
+		},
+		{
+			name:  "Board plays for both hands",
+			board: []string{"TS", "JS", "QS", "KS", "AS"},
+			hands: Hands{
+				"first":  {"2H", "3C"},
+				"second": {"9S", "8S"},
+			},
+			expectedWinners: []string{"first", "second"},
+			expectedBestCards: map[string][]string{
+				"first":  {"TS", "JS", "QS", "KS", "AS"},
+				"second": {"TS", "JS", "QS", "KS", "AS"},
+			},
+			expectedNames: map[string]string{
+				"first":  "Royal Flush",
+				"second": "Royal Flush",
+			},
+		},
+		{
+			name:  "Flop only",
+			board: []string{"5H", "4D", "3C"},
+			hands: Hands{
+				"first":  {"AH", "2C"},
+				"second": {"6D", "7C"},
+			},
+			expectedWinners: []string{"second"},
+			expectedBestCards: map[string][]string{
+				"first":  {"5H", "4D", "3C", "AH", "2C"},
+				"second": {"5H", "4D", "3C", "6D", "7C"},
+			},
+			expectedNames: map[string]string{
+				"first":  "Straight",
+				"second": "Straight",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := EvaluateHoldemHands(test.board, test.hands)
+			if err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+
+			if !reflect.DeepEqual(result.Winners, test.expectedWinners) {
+				t.Errorf("Expected winners %v, but got %v", test.expectedWinners, result.Winners)
+			}
+
+			for handName, handResult := range result.Result {
+				if handResult.CombinationName != test.expectedNames[handName] {
+					t.Errorf("Expected %s for %s, but got %s", test.expectedNames[handName], handName, handResult.CombinationName)
+				}
+
+				bestCards := append([]string{}, handResult.BestCards...)
+				expected := append([]string{}, test.expectedBestCards[handName]...)
+				sort.Strings(bestCards)
+				sort.Strings(expected)
+				if !reflect.DeepEqual(bestCards, expected) {
+					t.Errorf("Expected best cards %v for %s, but got %v", expected, handName, bestCards)
+				}
+			}
+		})
+	}
+}
+
+func TestEvaluateHoldemHands_InvalidCardCount(t *testing.T) {
+	tests := []struct {
+		name         string
+		board        []string
+		hands        Hands
+		expectedCode pokererr.Code
+	}{
+		{
+			name:         "Board too small",
+			board:        []string{"AS", "KD"},
+			hands:        Hands{"first": {"AH", "3C"}},
+			expectedCode: pokererr.CodeInvalidBoardSize,
+		},
+		{
+			name:         "Board too big",
+			board:        []string{"AS", "KD", "7C", "7H", "2S", "3S"},
+			hands:        Hands{"first": {"AH", "3C"}},
+			expectedCode: pokererr.CodeInvalidBoardSize,
+		},
+		{
+			name:         "Three hole cards",
+			board:        []string{"AS", "KD", "7C"},
+			hands:        Hands{"first": {"AH", "3C", "4C"}},
+			expectedCode: pokererr.CodeInvalidHoleCardCount,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := EvaluateHoldemHands(test.board, test.hands)
+
+			var pokerError *pokererr.Error
+			if !errors.As(err, &pokerError) || pokerError.Code != test.expectedCode {
+				t.Fatalf("Expected error code %s, but got %v", test.expectedCode, err)
+			}
+			if _, ok := pokerError.Data["max"]; !ok {
+				t.Errorf("Expected min and max in the error data, but got %v", pokerError.Data)
+			}
+		})
+	}
+}
@@ -6,7 +6,8 @@ type CardWeight int32
 
 var (
 	// Using array for cards representation because we already know cards count. So we can optimize some memory consumption.
-	cardsList = [13]CardName{"2", "3", "4", "5", "6", "7", "8", "9", "T", "J", "Q", "K", "A"}
+	cardsList     = [13]CardName{"2", "3", "4", "5", "6", "7", "8", "9", "T", "J", "Q", "K", "A"}
+	cardSuitsList = [4]CardSuit{"S", "H", "D", "C"}
 	// Map are used for convenient weights calculations.
 	cardWeights = map[CardName]CardWeight{"2": 2, "3": 3, "4": 4, "5": 5, "6": 6, "7": 7, "8": 8, "9": 9, "T": 10, "J": 11, "Q": 12, "K": 13, "A": 14}
 )
@@ -24,3 +25,19 @@ func (c *Card) ResolveWeight() CardWeight {
 func ResolveWeight(cardName CardName) CardWeight {
 	return cardWeights[cardName]
 }
+
+// String returns the card in the same notation it is accepted in, e.g. "TS".
+func (c Card) String() string {
+	return string(c.Name) + string(c.Suit)
+}
+
+// NewDeck returns all 52 cards ordered by suit and then by weight.
+func NewDeck() []Card {
+	deck := make([]Card, 0, len(cardsList)*len(cardSuitsList))
+	for _, suit := range cardSuitsList {
+		for _, name := range cardsList {
+			deck = append(deck, Card{Name: name, Suit: suit, Weight: ResolveWeight(name)})
+		}
+	}
+	return deck
+}
@@ -0,0 +1,62 @@
+package holdem
+
+// forEachCombination Complexity: O(C(n, k)) (binomial)
+// Calls fn with indexes of every k-element subset of n elements in lexicographic order.
+// The indexes slice is reused between calls, so fn must copy it if it needs to keep it.
+func forEachCombination(n, k int, fn func(indexes []int)) {
+	if k > n || k < 0 {
+		return
+	}
+
+	indexes := make([]int, k)
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	for {
+		fn(indexes)
+
+		i := k - 1
+		for i >= 0 && indexes[i] == n-k+i {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+
+		indexes[i]++
+		for j := i + 1; j < k; j++ {
+			indexes[j] = indexes[j-1] + 1
+		}
+	}
+}
+
+func pickCards(cards []Card, indexes []int) []Card {
+	picked := make([]Card, len(indexes))
+	for i, index := range indexes {
+		picked[i] = cards[index]
+	}
+	return picked
+}
+
+func cardStrings(cards []Card) []string {
+	result := make([]string, len(cards))
+	for i, card := range cards {
+		result[i] = card.String()
+	}
+	return result
+}
+
+// binomial returns the number of k-element subsets of n elements, as a float since it overflows integers quickly.
+func binomial(n, k int) float64 {
+	if k > n || k < 0 {
+		return 0
+	}
+
+	result := 1.0
+	for i := 0; i < k; i++ {
+		result = result * float64(n-i) / float64(i+1)
+	}
+
+	return result
+}
@@ -0,0 +1,259 @@
+package holdem
+
+import (
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// RankNames are the names of one card rank in a language, e.g. "Six" for one card and "Sixes" for a group of them.
+type RankNames struct {
+	One  string
+	Many string
+}
+
+// DescriptionTemplates are text/template templates of hand descriptions for every combination, executed with
+// DescriptionData. Low is used for unpaired low hands, paired low hands use the templates of their combinations.
+type DescriptionTemplates struct {
+	RoyalFlush    string
+	StraightFlush string
+	FiveOfAKind   string
+	FourOfAKind   string
+	FullHouse     string
+	Flush         string
+	Straight      string
+	ThreeOfAKind  string
+	TwoPair       string
+	Pair          string
+	HighCard      string
+	Low           string
+}
+
+// DescriptionData is what description templates are executed with.
+type DescriptionData struct {
+	// Combination is the combination name of the hand, e.g. "Two pair".
+	Combination string
+	// Ranks are all ranks of the hand in order of significance, e.g. Kings, Sevens and Ace of two pair.
+	Ranks []RankNames
+	// High and Second are the first two of Ranks, e.g. the pairs of two pair or the trips and the pair of a full house.
+	High   RankNames
+	Second RankNames
+	// Kicker is the highest kicker, nil when the combination has no kickers.
+	Kicker *RankNames
+	// Wheel tells that the hand is a five-high straight (A-2-3-4-5).
+	Wheel bool
+}
+
+// Descriptions turn hand results into human-readable descriptions in one language.
+type Descriptions struct {
+	ranks     map[CardName]RankNames
+	templates map[string]*template.Template
+	low       *template.Template
+}
+
+// EnglishDescriptions describe hands in English, e.g. "Two Pair, Kings and Sevens, Ace kicker".
+// They are used for the Description of every evaluated hand.
+var EnglishDescriptions = mustDescriptions(
+	map[CardName]RankNames{
+		"2": {One: "Deuce", Many: "Deuces"},
+		"3": {One: "Three", Many: "Threes"},
+		"4": {One: "Four", Many: "Fours"},
+		"5": {One: "Five", Many: "Fives"},
+		"6": {One: "Six", Many: "Sixes"},
+		"7": {One: "Seven", Many: "Sevens"},
+		"8": {One: "Eight", Many: "Eights"},
+		"9": {One: "Nine", Many: "Nines"},
+		"T": {One: "Ten", Many: "Tens"},
+		"J": {One: "Jack", Many: "Jacks"},
+		"Q": {One: "Queen", Many: "Queens"},
+		"K": {One: "King", Many: "Kings"},
+		"A": {One: "Ace", Many: "Aces"},
+	},
+	DescriptionTemplates{
+		RoyalFlush:    "Royal Flush",
+		StraightFlush: "Straight Flush, {{.High.One}}-high{{if .Wheel}} (steel wheel){{end}}",
+		FiveOfAKind:   "Five of a Kind, {{.High.Many}}",
+		FourOfAKind:   "Four of a Kind, {{.High.Many}}{{with .Kicker}}, {{.One}} kicker{{end}}",
+		FullHouse:     "Full House, {{.High.Many}} full of {{.Second.Many}}",
+		Flush:         "Flush, {{.High.One}}-high",
+		Straight:      "Straight, {{.High.One}}-high{{if .Wheel}} (wheel){{end}}",
+		ThreeOfAKind:  "Three of a Kind, {{.High.Many}}{{with .Kicker}}, {{.One}} kicker{{end}}",
+		TwoPair:       "Two Pair, {{.High.Many}} and {{.Second.Many}}{{with .Kicker}}, {{.One}} kicker{{end}}",
+		Pair:          "Pair of {{.High.Many}}{{with .Kicker}}, {{.One}} kicker{{end}}",
+		HighCard:      "High Card, {{.High.One}}{{with .Kicker}}, {{.One}} kicker{{end}}",
+		Low:           "{{range $i, $rank := .Ranks}}{{if $i}}-{{end}}{{$rank.One}}{{end}} low",
+	},
+)
+
+// NewDescriptions parses description templates, ranks missing from the names are described by their card names.
+func NewDescriptions(ranks map[CardName]RankNames, templates DescriptionTemplates) (*Descriptions, error) {
+	descriptions := &Descriptions{
+		ranks:     ranks,
+		templates: make(map[string]*template.Template),
+	}
+
+	byCombination := map[string]string{
+		"Royal Flush":     templates.RoyalFlush,
+		"Straight Flush":  templates.StraightFlush,
+		"Five of a kind":  templates.FiveOfAKind,
+		"Four of a kind":  templates.FourOfAKind,
+		"Full House":      templates.FullHouse,
+		"Flush":           templates.Flush,
+		"Straight":        templates.Straight,
+		"Three of a kind": templates.ThreeOfAKind,
+		"Two pair":        templates.TwoPair,
+		"Pair":            templates.Pair,
+		"High card":       templates.HighCard,
+	}
+	for combinationName, text := range byCombination {
+		parsed, err := template.New(combinationName).Parse(text)
+		if err != nil {
+			return nil, err
+		}
+		descriptions.templates[combinationName] = parsed
+	}
+
+	low, err := template.New("low").Parse(templates.Low)
+	if err != nil {
+		return nil, err
+	}
+	descriptions.low = low
+
+	return descriptions, nil
+}
+
+func mustDescriptions(ranks map[CardName]RankNames, templates DescriptionTemplates) *Descriptions {
+	descriptions, err := NewDescriptions(ranks, templates)
+	if err != nil {
+		panic(err)
+	}
+
+	return descriptions
+}
+
+// Describe Complexity: O(1) (constant time)
+// Returns the description of the hand in the language of the descriptions. Hands without known cards, e.g. of custom
+// evaluators that don't fill BestCards, are described by their combination name.
+func (r *HandResult) Describe(descriptions *Descriptions) string {
+	played := r.played
+	if played == nil {
+		cards, err := ParseCards(r.BestCards)
+		if err != nil {
+			return r.CombinationName
+		}
+		played = cards
+	}
+
+	tmpl, ok := descriptions.templates[r.CombinationName]
+	if r.low && r.CombinationWeight == highCardCombinationWeight {
+		tmpl, ok = descriptions.low, true
+	}
+	if !ok || len(played) == 0 {
+		return r.CombinationName
+	}
+
+	data := DescriptionData{Combination: r.CombinationName}
+	for i, card := range played {
+		if i == 0 || card.Name != played[i-1].Name {
+			data.Ranks = append(data.Ranks, descriptions.rankNames(card.Name))
+		}
+	}
+	data.High = data.Ranks[0]
+	if len(data.Ranks) > 1 {
+		data.Second = data.Ranks[1]
+	}
+	if len(r.Kickers) > 0 && len(r.Kickers) <= len(played) {
+		kicker := descriptions.rankNames(played[len(played)-len(r.Kickers)].Name)
+		data.Kicker = &kicker
+	}
+	data.Wheel = isStraightCombination(r.CombinationName) && played[0].Name == "5"
+
+	var description strings.Builder
+	if err := tmpl.Execute(&description, data); err != nil {
+		return r.CombinationName
+	}
+
+	return description.String()
+}
+
+func (d *Descriptions) rankNames(name CardName) RankNames {
+	if names, ok := d.ranks[name]; ok {
+		return names
+	}
+
+	return RankNames{One: string(name), Many: string(name)}
+}
+
+// Describe sets the description of every hand in the language of the descriptions.
+func (r *EvaluateResult) Describe(descriptions *Descriptions) {
+	for _, handResult := range r.Seats {
+		handResult.Description = handResult.Describe(descriptions)
+	}
+}
+
+// explain Complexity: O(n log n) (linearithmic time)
+// Orders five cards of the result by significance and fills BestCards and Kickers. Cards of bigger groups go first
+// and groups of the same size go from the highest weight to the lowest, straights go from the top card, so the ace of
+// the wheel is the last one. played are the cards the way they count in the combination, e.g. with wild cards replaced,
+// and weights are their weights by the rules of the game.
+func (r *HandResult) explain(cards, played []Card, weights []CardWeight) {
+	counts := make(map[CardName]int, len(played))
+	for _, card := range played {
+		counts[card.Name]++
+	}
+
+	straight := isStraightCombination(r.CombinationName)
+	aceLow := straight && counts["A"] > 0 && counts["K"] == 0
+
+	order := make([]int, len(played))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		first, second := played[order[i]], played[order[j]]
+		if aceLow && (first.Name == "A") != (second.Name == "A") {
+			return second.Name == "A"
+		}
+		if !straight && counts[first.Name] != counts[second.Name] {
+			return counts[first.Name] > counts[second.Name]
+		}
+		return weights[order[i]] > weights[order[j]]
+	})
+
+	r.BestCards = make([]string, len(order))
+	r.played = make([]Card, len(order))
+	r.Kickers = nil
+	for i, index := range order {
+		r.BestCards[i] = cards[index].String()
+		r.played[i] = played[index]
+	}
+
+	if !hasKickers(r.CombinationName) {
+		return
+	}
+	for i, card := range r.played {
+		// The top card of a high card hand is the combination itself.
+		if counts[card.Name] == 1 && !(i == 0 && r.CombinationName == "High card") {
+			r.Kickers = append(r.Kickers, r.BestCards[i])
+		}
+	}
+}
+
+func isStraightCombination(combinationName string) bool {
+	switch combinationName {
+	case "Straight", "Straight Flush", "Royal Flush":
+		return true
+	}
+
+	return false
+}
+
+// hasKickers tells whether unpaired cards of the combination are kickers, all cards of other combinations play.
+func hasKickers(combinationName string) bool {
+	switch combinationName {
+	case "Four of a kind", "Three of a kind", "Two pair", "Pair", "High card":
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,147 @@
+package holdem
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHandResult_Describe(t *testing.T) {
+	tests := []struct {
+		name                string
+		cards               []string
+		define              func(h *Hand) *HandResult
+		expectedDescription string
+		expectedBestCards   []string
+		expectedKickers     []string
+	}{
+		{
+			name:                "Two pair",
+			cards:               []string{"7S", "KH", "AD", "7C", "KS"},
+			define:              (*Hand).DefineCombination,
+			expectedDescription: "Two Pair, Kings and Sevens, Ace kicker",
+			expectedBestCards:   []string{"KH", "KS", "7S", "7C", "AD"},
+			expectedKickers:     []string{"AD"},
+		},
+		{
+			name:                "Wheel",
+			cards:               []string{"3S", "AH", "5D", "2C", "4S"},
+			define:              (*Hand).DefineCombination,
+			expectedDescription: "Straight, Five-high (wheel)",
+			expectedBestCards:   []string{"5D", "4S", "3S", "2C", "AH"},
+		},
+		{
+			name:                "Steel wheel",
+			cards:               []string{"3S", "AS", "5S", "2S", "4S"},
+			define:              (*Hand).DefineCombination,
+			expectedDescription: "Straight Flush, Five-high (steel wheel)",
+			expectedBestCards:   []string{"5S", "4S", "3S", "2S", "AS"},
+		},
+		{
+			name:                "Full house",
+			cards:               []string{"6S", "QH", "6D", "QC", "6C"},
+			define:              (*Hand).DefineCombination,
+			expectedDescription: "Full House, Sixes full of Queens",
+			expectedBestCards:   []string{"6S", "6D", "6C", "QH", "QC"},
+		},
+		{
+			name:                "Pair",
+			cards:               []string{"9S", "JH", "4D", "JC", "2C"},
+			define:              (*Hand).DefineCombination,
+			expectedDescription: "Pair of Jacks, Nine kicker",
+			expectedBestCards:   []string{"JH", "JC", "9S", "4D", "2C"},
+			expectedKickers:     []string{"9S", "4D", "2C"},
+		},
+		{
+			name:                "High card",
+			cards:               []string{"9S", "JH", "4D", "KC", "2C"},
+			define:              (*Hand).DefineCombination,
+			expectedDescription: "High Card, King, Jack kicker",
+			expectedBestCards:   []string{"KC", "JH", "9S", "4D", "2C"},
+			expectedKickers:     []string{"JH", "9S", "4D", "2C"},
+		},
+		{
+			name:                "Ace-to-five low",
+			cards:               []string{"3S", "AH", "7D", "5C", "4S"},
+			define:              (*Hand).DefineAceToFiveLow,
+			expectedDescription: "Seven-Five-Four-Three-Ace low",
+			expectedBestCards:   []string{"7D", "5C", "4S", "3S", "AH"},
+		},
+		{
+			name:                "Short Deck straight with a low ace",
+			cards:               []string{"9S", "AH", "7D", "8C", "6S"},
+			define:              func(h *Hand) *HandResult { return h.DefineShortDeckCombination(ShortDeckOptions{}) },
+			expectedDescription: "Straight, Nine-high",
+			expectedBestCards:   []string{"9S", "8C", "7D", "6S", "AH"},
+		},
+		{
+			name:  "Joker",
+			cards: []string{"KS", "KH", "JK", "KC", "3S"},
+			define: func(h *Hand) *HandResult {
+				return h.DefineWildCombination(WildOptions{})
+			},
+			expectedDescription: "Four of a Kind, Kings, Three kicker",
+			expectedBestCards:   []string{"KS", "KH", "KC", "JK", "3S"},
+			expectedKickers:     []string{"3S"},
+		},
+		{
+			name:                "Best five of seven cards",
+			cards:               []string{"QS", "QH", "2D", "7C", "QD", "AS", "3H"},
+			define:              (*Hand).BestCombination,
+			expectedDescription: "Three of a Kind, Queens, Ace kicker",
+			expectedBestCards:   []string{"QS", "QH", "QD", "AS", "7C"},
+			expectedKickers:     []string{"AS", "7C"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hand := Hand{Name: "Test Hand"}
+			for _, cardString := range test.cards {
+				if cardString == Joker.String() {
+					hand.Cards = append(hand.Cards, Joker)
+					continue
+				}
+				hand.Cards = append(hand.Cards, cardOf(cardString))
+			}
+
+			result := test.define(&hand)
+
+			if description := result.Describe(EnglishDescriptions); description != test.expectedDescription {
+				t.Errorf("Expected description %q, but got %q", test.expectedDescription, description)
+			}
+			if !reflect.DeepEqual(result.BestCards, test.expectedBestCards) {
+				t.Errorf("Expected best cards %v, but got %v", test.expectedBestCards, result.BestCards)
+			}
+			if !reflect.DeepEqual(result.Kickers, test.expectedKickers) {
+				t.Errorf("Expected kickers %v, but got %v", test.expectedKickers, result.Kickers)
+			}
+		})
+	}
+}
+
+func TestNewDescriptions(t *testing.T) {
+	german, err := NewDescriptions(
+		map[CardName]RankNames{"K": {One: "König", Many: "Könige"}, "7": {One: "Sieben", Many: "Siebenen"}},
+		DescriptionTemplates{TwoPair: "Zwei Paare, {{.High.Many}} und {{.Second.Many}}{{with .Kicker}}, Kicker {{.One}}{{end}}"},
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	result, err := EvaluateAndCompareHands(Hands{"first": {"7S", "KH", "AD", "7C", "KS"}})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if description := result.Result["first"].Description; description != "Two Pair, Kings and Sevens, Ace kicker" {
+		t.Errorf("Expected the English description by default, but got %q", description)
+	}
+
+	result.Describe(german)
+	if description := result.Result["first"].Description; description != "Zwei Paare, Könige und Siebenen, Kicker A" {
+		t.Errorf("Expected the German description, but got %q", description)
+	}
+
+	if _, err := NewDescriptions(nil, DescriptionTemplates{Pair: "{{.High"}); err == nil {
+		t.Error("Expected an error for an invalid template")
+	}
+}
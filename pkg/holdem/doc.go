@@ -0,0 +1,24 @@
+// Package holdem evaluates and compares poker hands.
+//
+// Cards are written as a rank and a suit, e.g. "AS" or "TH", and are parsed with ParseCard and ParseCards. Every
+// evaluation validates the whole deal first, so unknown or duplicate cards are reported as *pokererr.Error values with
+// codes from the pkg/error package instead of being ranked.
+//
+// The main entry points are:
+//   - EvaluateAndCompareHands ranks hands of five private cards;
+//   - EvaluateHoldemHands ranks Texas Hold'em hands against a shared board;
+//   - EvaluateGame ranks hands of any registered game, see Games and RegisterEvaluator;
+//   - CalculateEquity and CalculateRangeEquity compute win, tie and lose percentages of hands and ranges;
+//   - EvaluatePacked ranks 5, 6 or 7 packed cards without allocations for simulations.
+//
+// Every hand is ranked with a HandRank, a greater rank always wins and equal ranks split the pot, so results of different
+// evaluations can be compared directly.
+//
+// # Compatibility
+//
+// The package follows semantic versioning with the module tags. Within a major version exported identifiers are not
+// removed or changed in an incompatible way, the JSON field names of results and the error codes stay the same, and
+// HandRank values of the same hand don't change, so ranks may be stored and compared later. New games, functions,
+// result fields and error codes may be added in minor versions. The cmd and internal directories are not covered by
+// these guarantees.
+package holdem
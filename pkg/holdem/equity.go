@@ -0,0 +1,426 @@
+package holdem
+
+import (
+	"context"
+	"math/rand"
+	"runtime"
+	"sync"
+	"time"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+const (
+	minEquityHandsCount = 2
+	// Monte Carlo iterations are split into batches of this size, every batch has its own seed derived from
+	// the request seed. So the result depends only on the seed and not on the number of workers.
+	equityBatchSize = 10000
+	// Workers check the context once per this many boards during exhaustive enumeration.
+	equityCancelCheckInterval = 4096
+	deckCardsCount            = 52
+)
+
+type EquityRequest struct {
+	// Hands are hole cards of every player, exactly two for each.
+	Hands Hands
+	// Seats are hole cards of every player in seat order, they are used instead of Hands when set.
+	Seats Seats
+	// Board is the known part of the board, from zero to five cards.
+	Board []string
+	// Dead are cards that are known to be out of the deck, e.g. folded cards.
+	Dead []string
+	// Iterations is the number of random boards for Monte Carlo simulation. Zero means exhaustive enumeration of all boards.
+	Iterations int
+	// Seed makes Monte Carlo simulation reproducible. Zero means a random seed, the used one is returned in the result.
+	Seed int64
+	// Workers is the number of goroutines, zero means the number of CPUs.
+	Workers int
+}
+
+type EquityResult struct {
+	Hands map[string]*HandEquity `json:"hands"`
+	// Seats are the same equities as Hands in seat order.
+	Seats []*HandEquity `json:"seats"`
+	// Boards is the number of evaluated boards.
+	Boards     int64 `json:"boards"`
+	Exhaustive bool  `json:"exhaustive"`
+	Seed       int64 `json:"seed,omitempty"`
+}
+
+// HandEquity holds percentages of boards a hand wins alone, splits with other hands or loses.
+// Equity is the expected share of the pot, where a split counts as a fraction of the pot.
+type HandEquity struct {
+	HandName string  `json:"handName"`
+	Win      float64 `json:"win"`
+	Tie      float64 `json:"tie"`
+	Lose     float64 `json:"lose"`
+	Equity   float64 `json:"equity"`
+}
+
+// equityStats are raw counters of one hand, they are merged from all workers and converted to HandEquity at the end.
+// Counters are weighted, every board adds its weight to the total and to one of the outcomes.
+type equityStats struct {
+	wins, ties, losses, share, total float64
+}
+
+// record adds the outcome of one board, where best is the best rank on the board shared by the given number of winners.
+func (s *equityStats) record(rank, best HandRank, winners int, weight float64) {
+	s.total += weight
+
+	switch {
+	case rank != best:
+		s.losses += weight
+	case winners == 1:
+		s.wins += weight
+		s.share += weight
+	default:
+		s.ties += weight
+		s.share += weight / float64(winners)
+	}
+}
+
+func (s *equityStats) merge(other equityStats) {
+	s.wins += other.wins
+	s.ties += other.ties
+	s.losses += other.losses
+	s.share += other.share
+	s.total += other.total
+}
+
+// CalculateEquity computes win, tie and lose percentages of Texas Hold'em hands over all possible completions
+// of the board, or over a random sample of them when Iterations is set. The work is spread across goroutines
+// and stops with the context error as soon as the context is done.
+func CalculateEquity(ctx context.Context, req EquityRequest) (*EquityResult, error) {
+	seats := req.Seats
+	if seats == nil {
+		seats = req.Hands.Seats()
+	}
+
+	if len(seats) < minEquityHandsCount {
+		return nil, pokererr.NewError(pokererr.CodeNotEnoughHands, pokererr.Data{
+			"count": len(seats),
+			"min":   minEquityHandsCount,
+		})
+	}
+	if len(req.Board) > maxBoardCardsCount {
+		return nil, pokererr.NewError(pokererr.CodeInvalidBoardSize, pokererr.Data{
+			"count": len(req.Board),
+			"min":   0,
+			"max":   maxBoardCardsCount,
+		})
+	}
+	if req.Iterations < 0 {
+		return nil, pokererr.NewError(pokererr.CodeInvalidIterations, pokererr.Data{"iterations": req.Iterations})
+	}
+	for _, seat := range seats {
+		if len(seat.Cards) != holdemHoleCardsCount {
+			return nil, pokererr.NewError(pokererr.CodeInvalidHoleCardCount, pokererr.Data{
+				"hand":  seat.Name,
+				"count": len(seat.Cards),
+				"min":   holdemHoleCardsCount,
+				"max":   holdemHoleCardsCount,
+			})
+		}
+	}
+	if err := validateDealSize(len(seats), len(req.Dead)); err != nil {
+		return nil, err
+	}
+
+	parser := newDealParser()
+	board := parser.parse(boardLocation, req.Board)
+	parser.parse(deadLocation, req.Dead)
+	hands := parser.parseSeats(seats)
+	if err := parser.err(); err != nil {
+		return nil, err
+	}
+
+	deal := newEquityDeal(board, hands, remainingDeck(parser.used()))
+
+	workers := req.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	result := &EquityResult{
+		Hands: make(map[string]*HandEquity, len(hands)),
+		Seats: make([]*HandEquity, 0, len(hands)),
+	}
+
+	var (
+		stats []equityStats
+		err   error
+	)
+	if req.Iterations == 0 {
+		result.Exhaustive = true
+		stats, result.Boards, err = deal.enumerate(ctx, workers)
+	} else {
+		result.Seed = req.Seed
+		if result.Seed == 0 {
+			result.Seed = time.Now().UnixNano()
+		}
+		stats, result.Boards, err = deal.simulate(ctx, workers, req.Iterations, result.Seed)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i, hand := range hands {
+		handEquity := stats[i].toHandEquity(hand.Name)
+		result.Hands[hand.Name] = handEquity
+		result.Seats = append(result.Seats, handEquity)
+	}
+
+	return result, nil
+}
+
+func (s equityStats) toHandEquity(handName string) *HandEquity {
+	equity := &HandEquity{HandName: handName}
+	if s.total == 0 {
+		return equity
+	}
+
+	equity.Win = 100 * s.wins / s.total
+	equity.Tie = 100 * s.ties / s.total
+	equity.Lose = 100 * s.losses / s.total
+	equity.Equity = 100 * s.share / s.total
+
+	return equity
+}
+
+// equityDeal is a deal with a known set of hole cards and a partially known board.
+type equityDeal struct {
+	board   []PackedCard
+	holes   [][2]PackedCard
+	deck    []PackedCard
+	missing int
+}
+
+func newEquityDeal(board []Card, hands []Hand, deck []Card) *equityDeal {
+	deal := &equityDeal{
+		board:   PackCards(board),
+		holes:   make([][2]PackedCard, len(hands)),
+		deck:    PackCards(deck),
+		missing: maxBoardCardsCount - len(board),
+	}
+	for i, hand := range hands {
+		deal.holes[i] = [2]PackedCard{PackCard(hand.Cards[0]), PackCard(hand.Cards[1])}
+	}
+
+	return deal
+}
+
+// enumerate Complexity: O(C(n, k)) (binomial)
+// Evaluates every completion of the board. Jobs are the indexes of the first missing card, so workers
+// pick them up one by one and enumerate the rest of the cards independently.
+func (d *equityDeal) enumerate(ctx context.Context, workers int) ([]equityStats, int64, error) {
+	if d.missing == 0 {
+		stats := make([]equityStats, len(d.holes))
+		scoreBoard(d.holes, d.board, make([]HandRank, len(d.holes)), stats)
+		return stats, 1, nil
+	}
+
+	jobs := make(chan int)
+	go func() {
+		defer close(jobs)
+		for first := 0; first <= len(d.deck)-d.missing; first++ {
+			select {
+			case <-ctx.Done():
+				return
+			case jobs <- first:
+			}
+		}
+	}()
+
+	return d.run(ctx, workers, func(stats []equityStats) (int64, error) {
+		var (
+			boards int64
+			board  = append(append(make([]PackedCard, 0, maxBoardCardsCount), d.board...), make([]PackedCard, d.missing)...)
+			ranks  = make([]HandRank, len(d.holes))
+			known  = len(d.board)
+		)
+
+		for first := range jobs {
+			board[known] = d.deck[first]
+			rest := d.deck[first+1:]
+
+			var cancelled bool
+			forEachCombination(len(rest), d.missing-1, func(indexes []int) {
+				if cancelled {
+					return
+				}
+				for i, index := range indexes {
+					board[known+1+i] = rest[index]
+				}
+				scoreBoard(d.holes, board, ranks, stats)
+				boards++
+
+				if boards%equityCancelCheckInterval == 0 && ctx.Err() != nil {
+					cancelled = true
+				}
+			})
+			if cancelled {
+				return boards, ctx.Err()
+			}
+		}
+
+		return boards, ctx.Err()
+	})
+}
+
+// simulate Complexity: O(n) (linear time)
+// Evaluates the given number of random completions of the board, drawn by a partial Fisher-Yates shuffle.
+func (d *equityDeal) simulate(ctx context.Context, workers, iterations int, seed int64) ([]equityStats, int64, error) {
+	jobs := make(chan int)
+	go func() {
+		defer close(jobs)
+		for batch := 0; batch*equityBatchSize < iterations; batch++ {
+			select {
+			case <-ctx.Done():
+				return
+			case jobs <- batch:
+			}
+		}
+	}()
+
+	return d.run(ctx, workers, func(stats []equityStats) (int64, error) {
+		var (
+			boards int64
+			board  = append(append(make([]PackedCard, 0, maxBoardCardsCount), d.board...), make([]PackedCard, d.missing)...)
+			ranks  = make([]HandRank, len(d.holes))
+			deck   = make([]PackedCard, len(d.deck))
+			known  = len(d.board)
+		)
+
+		for batch := range jobs {
+			if err := ctx.Err(); err != nil {
+				return boards, err
+			}
+
+			random := rand.New(rand.NewSource(seed + int64(batch)))
+			copy(deck, d.deck)
+
+			size := iterations - batch*equityBatchSize
+			if size > equityBatchSize {
+				size = equityBatchSize
+			}
+
+			for i := 0; i < size; i++ {
+				for j := 0; j < d.missing; j++ {
+					k := j + random.Intn(len(deck)-j)
+					deck[j], deck[k] = deck[k], deck[j]
+					board[known+j] = deck[j]
+				}
+				scoreBoard(d.holes, board, ranks, stats)
+				boards++
+			}
+		}
+
+		return boards, ctx.Err()
+	})
+}
+
+// validateDealSize rejects deals that need more cards than the deck has: the hole cards of every hand, the whole
+// board, known and missing cards together, and the dead cards. Dealing such boards would run out of the deck.
+func validateDealSize(hands, dead int) error {
+	needed := hands*holdemHoleCardsCount + maxBoardCardsCount + dead
+	if needed > deckCardsCount {
+		return pokererr.NewError(pokererr.CodeNotEnoughCards, pokererr.Data{
+			"needed": needed,
+			"deck":   deckCardsCount,
+		})
+	}
+
+	return nil
+}
+
+// run starts workers with their own counters and merges the counters when all workers are done.
+func (d *equityDeal) run(
+	ctx context.Context,
+	workers int,
+	work func(stats []equityStats) (int64, error),
+) ([]equityStats, int64, error) {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		total    = make([]equityStats, len(d.holes))
+		boards   int64
+		firstErr error
+	)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			stats := make([]equityStats, len(d.holes))
+			count, err := work(stats)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+			boards += count
+			for i := range total {
+				total[i].merge(stats[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	if firstErr != nil {
+		return nil, 0, firstErr
+	}
+
+	return total, boards, nil
+}
+
+// scoreBoard Complexity: O(n) (linear time)
+// Evaluates every hand on the complete board and updates win, tie and lose counters.
+// Ranks is a buffer of the same length as holes, so the function doesn't allocate.
+func scoreBoard(holes [][2]PackedCard, board []PackedCard, ranks []HandRank, stats []equityStats) {
+	best, winners := rankBoard(holes, board, ranks)
+	for i := range holes {
+		stats[i].record(ranks[i], best, winners, 1)
+	}
+}
+
+// rankBoard Complexity: O(n) (linear time)
+// Fills ranks of every hand on the complete board and returns the best rank with the number of hands that have it.
+func rankBoard(holes [][2]PackedCard, board []PackedCard, ranks []HandRank) (HandRank, int) {
+	var (
+		cards   [maxBoardCardsCount + holdemHoleCardsCount]PackedCard
+		best    HandRank
+		winners int
+	)
+	copy(cards[:], board)
+
+	for i, hole := range holes {
+		cards[maxBoardCardsCount], cards[maxBoardCardsCount+1] = hole[0], hole[1]
+		ranks[i] = EvaluatePacked(cards[:])
+
+		switch {
+		case ranks[i] > best:
+			best, winners = ranks[i], 1
+		case ranks[i] == best:
+			winners++
+		}
+	}
+
+	return best, winners
+}
+
+func remainingDeck(used map[Card]bool) []Card {
+	deck := NewDeck()
+	remaining := deck[:0]
+	for _, card := range deck {
+		if !used[card] {
+			remaining = append(remaining, card)
+		}
+	}
+
+	return remaining
+}
@@ -0,0 +1,206 @@
+package holdem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+func TestCalculateEquity_Exhaustive(t *testing.T) {
+	result, err := CalculateEquity(context.Background(), EquityRequest{
+		Hands: Hands{
+			"first":  {"AH", "AD"},
+			"second": {"QH", "JH"},
+		},
+		Board: []string{"AS", "KD", "7C", "2H"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if !result.Exhaustive || result.Boards != 44 {
+		t.Fatalf("Expected exhaustive enumeration of 44 boards, but got %d", result.Boards)
+	}
+
+	// Only four tens make the straight for the second hand.
+	expectEquity(t, result.Hands["second"], 100*4.0/44, 0)
+	expectEquity(t, result.Hands["first"], 100*40.0/44, 0)
+}
+
+func TestCalculateEquity_Split(t *testing.T) {
+	result, err := CalculateEquity(context.Background(), EquityRequest{
+		Hands: Hands{
+			"first":  {"2H", "3D"},
+			"second": {"2C", "3C"},
+		},
+		Board:   []string{"TS", "JS", "QS"},
+		Dead:    []string{"KS", "AS"},
+		Workers: 3,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if result.Boards != 903 {
+		t.Fatalf("Expected 903 boards, but got %d", result.Boards)
+	}
+	expectEquity(t, result.Hands["first"], 0, 100)
+	expectEquity(t, result.Hands["second"], 0, 100)
+}
+
+func TestCalculateEquity_MonteCarlo(t *testing.T) {
+	request := EquityRequest{
+		Hands: Hands{
+			"first":  {"AH", "AD"},
+			"second": {"KC", "KS"},
+		},
+		Iterations: 25000,
+		Seed:       42,
+		Workers:    1,
+	}
+
+	first, err := CalculateEquity(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	request.Workers = 4
+	second, err := CalculateEquity(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if first.Boards != 25000 || first.Exhaustive {
+		t.Errorf("Expected 25000 random boards, but got %d", first.Boards)
+	}
+	if *first.Hands["first"] != *second.Hands["first"] {
+		t.Errorf("Expected the same result for the same seed, but got %v and %v", first.Hands["first"], second.Hands["first"])
+	}
+	// Aces are about 82% against kings.
+	if equity := first.Hands["first"].Equity; equity < 80 || equity > 84 {
+		t.Errorf("Expected equity of aces about 82%%, but got %v", equity)
+	}
+}
+
+func TestCalculateEquity_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := CalculateEquity(ctx, EquityRequest{
+		Hands: Hands{
+			"first":  {"AH", "AD"},
+			"second": {"KC", "KS"},
+		},
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, but got %v", err)
+	}
+}
+
+func TestCalculateEquity_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name         string
+		request      EquityRequest
+		expectedCode pokererr.Code
+	}{
+		{
+			name:         "One hand",
+			request:      EquityRequest{Hands: Hands{"first": {"AH", "AD"}}},
+			expectedCode: pokererr.CodeNotEnoughHands,
+		},
+		{
+			name: "Dead card in hand",
+			request: EquityRequest{
+				Hands: Hands{"first": {"AH", "AD"}, "second": {"KC", "KS"}},
+				Dead:  []string{"KS"},
+			},
+			expectedCode: pokererr.CodeDuplicateCard,
+		},
+		{
+			name: "Negative iterations",
+			request: EquityRequest{
+				Hands:      Hands{"first": {"AH", "AD"}, "second": {"KC", "KS"}},
+				Iterations: -1,
+			},
+			expectedCode: pokererr.CodeInvalidIterations,
+		},
+		{
+			name:         "Three hole cards",
+			request:      EquityRequest{Hands: Hands{"first": {"AH", "AD", "AC"}, "second": {"KC", "KS"}}},
+			expectedCode: pokererr.CodeInvalidHoleCardCount,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := CalculateEquity(context.Background(), test.request)
+
+			var pokerError *pokererr.Error
+			if !errors.As(err, &pokerError) || pokerError.Code != test.expectedCode {
+				t.Fatalf("Expected error code %s, but got %v", test.expectedCode, err)
+			}
+			if test.expectedCode == pokererr.CodeInvalidHoleCardCount && pokerError.Data["max"] != holdemHoleCardsCount {
+				t.Errorf("Expected max %d in the error data, but got %v", holdemHoleCardsCount, pokerError.Data)
+			}
+		})
+	}
+}
+
+// TestCalculateEquity_TooManyHands checks that deals running out of the deck are rejected instead of panicking
+// in a worker while the random boards are dealt.
+func TestCalculateEquity_TooManyHands(t *testing.T) {
+	var cards []string
+	for _, rank := range "23456789TJQKA" {
+		for _, suit := range "CDHS" {
+			cards = append(cards, string(rank)+string(suit))
+		}
+	}
+
+	seats := make(Seats, 24)
+	for i := range seats {
+		seats[i] = Seat{Name: fmt.Sprintf("seat%d", i), Cards: cards[2*i : 2*i+2]}
+	}
+
+	tests := []struct {
+		name    string
+		request EquityRequest
+	}{
+		{name: "Monte Carlo", request: EquityRequest{Seats: seats, Iterations: 1000}},
+		{name: "Exhaustive", request: EquityRequest{Seats: seats}},
+		{name: "Dead cards", request: EquityRequest{Seats: seats[:23], Dead: cards[46:48], Iterations: 1000}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := CalculateEquity(context.Background(), test.request)
+
+			var pokerError *pokererr.Error
+			if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeNotEnoughCards {
+				t.Errorf("Expected error code %s, but got %v", pokererr.CodeNotEnoughCards, err)
+			}
+		})
+	}
+
+	result, err := CalculateEquity(context.Background(), EquityRequest{Seats: seats[:23], Iterations: 1000})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if result.Boards != 1000 {
+		t.Errorf("Expected 1000 boards of the full deal, but got %d", result.Boards)
+	}
+}
+
+func expectEquity(t *testing.T, equity *HandEquity, win, tie float64) {
+	t.Helper()
+
+	if math.Abs(equity.Win-win) > 1e-9 || math.Abs(equity.Tie-tie) > 1e-9 {
+		t.Errorf("Expected %s to win %v%% and tie %v%%, but got %v", equity.HandName, win, tie, equity)
+	}
+	if sum := equity.Win + equity.Tie + equity.Lose; math.Abs(sum-100) > 1e-9 {
+		t.Errorf("Expected percentages of %s to sum to 100, but got %v", equity.HandName, sum)
+	}
+}
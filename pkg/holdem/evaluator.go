@@ -0,0 +1,119 @@
+package holdem
+
+import (
+	"sort"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+type EvaluateResult struct {
+	Result map[string]*HandResult `json:"result"`
+	// Seats are the same results as Result in seat order, hands ranked by name come in name order.
+	Seats []*HandResult `json:"seats"`
+	// Ranking lists all hands from the best to the worst, hands with equal rank share a place.
+	Ranking []*RankingPlace `json:"ranking"`
+	// Winners are the hands of the first place, more than one winner means a split pot.
+	Winners []string `json:"winners"`
+	// Ties are all places shared by more than one hand.
+	Ties [][]string `json:"ties"`
+}
+
+type RankingPlace struct {
+	Place           int      `json:"place"`
+	Hands           []string `json:"hands"`
+	CombinationName string   `json:"combinationName"`
+	Rank            HandRank `json:"rank"`
+}
+
+func EvaluateAndCompareHands(hands Hands) (*EvaluateResult, error) {
+	return EvaluateAndCompareSeats(hands.Seats())
+}
+
+// EvaluateAndCompareSeats is EvaluateAndCompareHands for hands in seat order. Every hand must have exactly five cards.
+func EvaluateAndCompareSeats(seats Seats) (*EvaluateResult, error) {
+	for _, seat := range seats {
+		if len(seat.Cards) != combinationCardsCount {
+			return nil, pokererr.NewError(pokererr.CodeInvalidHoleCardCount, pokererr.Data{
+				"hand":  seat.Name,
+				"count": len(seat.Cards),
+				"min":   combinationCardsCount,
+				"max":   combinationCardsCount,
+			})
+		}
+	}
+
+	_, handsWithCards, err := parseDeal(nil, seats)
+	if err != nil {
+		return nil, err
+	}
+
+	handCombinations := make([]*HandResult, 0, len(handsWithCards))
+
+	for _, hand := range handsWithCards {
+		handCombinations = append(handCombinations, hand.DefineCombination())
+	}
+
+	return newEvaluateResult(handCombinations), nil
+}
+
+// newEvaluateResult builds the result of hands in seat order, nil results (e.g. hands without a qualifying low) are
+// left out.
+func newEvaluateResult(handCombinations []*HandResult) *EvaluateResult {
+	result := &EvaluateResult{
+		Result:  make(map[string]*HandResult, len(handCombinations)),
+		Seats:   make([]*HandResult, 0, len(handCombinations)),
+		Winners: []string{},
+		Ties:    [][]string{},
+	}
+
+	for _, handResult := range handCombinations {
+		if handResult != nil {
+			result.Result[handResult.HandName] = handResult
+			result.Seats = append(result.Seats, handResult)
+		}
+	}
+	result.Ranking = rankHands(result.Seats)
+	result.Describe(EnglishDescriptions)
+
+	if len(result.Ranking) > 0 {
+		result.Winners = result.Ranking[0].Hands
+	}
+
+	for _, place := range result.Ranking {
+		if len(place.Hands) > 1 {
+			result.Ties = append(result.Ties, place.Hands)
+		}
+	}
+
+	return result
+}
+
+// rankHands Complexity: O(n log n) (linearithmic time)
+// Hands are sorted by rank from the highest to the lowest and hands with equal rank are grouped into one place.
+// The sort is stable, so inside a place hands keep seat order and the output is the same on every run.
+func rankHands(seats []*HandResult) []*RankingPlace {
+	ranked := make([]*HandResult, len(seats))
+	copy(ranked, seats)
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Rank > ranked[j].Rank
+	})
+
+	places := make([]*RankingPlace, 0, len(ranked))
+	for _, handResult := range ranked {
+		if len(places) > 0 && places[len(places)-1].Rank == handResult.Rank {
+			last := places[len(places)-1]
+			last.Hands = append(last.Hands, handResult.HandName)
+			continue
+		}
+
+		places = append(places, &RankingPlace{
+			Place:           len(places) + 1,
+			Hands:           []string{handResult.HandName},
+			CombinationName: handResult.CombinationName,
+			Rank:            handResult.Rank,
+		})
+	}
+
+	return places
+}
@@ -0,0 +1,108 @@
+package holdem
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+func TestEvaluateAndCompareHands(t *testing.T) {
+	tests := []struct {
+		name            string
+		hands           Hands
+		expectedWinners []string
+		expectedTies    [][]string
+		expectedPlaces  [][]string
+	}{
+		{
+			name: "Single winner",
+			hands: Hands{
+				"first":  {"7H", "8H", "9H", "TH", "JH"},
+				"second": {"TS", "JS", "QS", "KS", "AS"},
+				"third":  {"2H", "2D", "5C", "9S", "KD"},
+			},
+			expectedWinners: []string{"second"},
+			expectedTies:    [][]string{},
+			expectedPlaces:  [][]string{{"second"}, {"first"}, {"third"}},
+		},
+		{
+			name: "Split pot",
+			hands: Hands{
+				"first":  {"AH", "KD", "9C", "7S", "2H"},
+				"second": {"AD", "KC", "9S", "7H", "2C"},
+				"third":  {"AS", "QC", "9D", "7C", "2S"},
+			},
+			expectedWinners: []string{"first", "second"},
+			expectedTies:    [][]string{{"first", "second"}},
+			expectedPlaces:  [][]string{{"first", "second"}, {"third"}},
+		},
+		{
+			name: "Tie below the first place",
+			hands: Hands{
+				"first":  {"AH", "AD", "9C", "7S", "2H"},
+				"second": {"KD", "KC", "9S", "7H", "2C"},
+				"third":  {"KS", "KH", "9D", "7C", "2S"},
+			},
+			expectedWinners: []string{"first"},
+			expectedTies:    [][]string{{"second", "third"}},
+			expectedPlaces:  [][]string{{"first"}, {"second", "third"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := EvaluateAndCompareHands(test.hands)
+			if err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+
+			if !reflect.DeepEqual(result.Winners, test.expectedWinners) {
+				t.Errorf("Expected winners %v, but got %v", test.expectedWinners, result.Winners)
+			}
+			if !reflect.DeepEqual(result.Ties, test.expectedTies) {
+				t.Errorf("Expected ties %v, but got %v", test.expectedTies, result.Ties)
+			}
+
+			places := make([][]string, 0, len(result.Ranking))
+			for i, place := range result.Ranking {
+				if place.Place != i+1 {
+					t.Errorf("Expected place %d, but got %d", i+1, place.Place)
+				}
+				places = append(places, place.Hands)
+			}
+			if !reflect.DeepEqual(places, test.expectedPlaces) {
+				t.Errorf("Expected ranking %v, but got %v", test.expectedPlaces, places)
+			}
+		})
+	}
+}
+
+func TestEvaluateAndCompareHands_InvalidCardCount(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []string
+	}{
+		{name: "No cards", cards: []string{}},
+		{name: "Six cards", cards: []string{"2H", "7H", "9H", "JH", "KH", "3C"}},
+		{name: "Seven cards", cards: []string{"2H", "7H", "9H", "JH", "KH", "AS", "AD"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := EvaluateAndCompareHands(Hands{
+				"first":  test.cards,
+				"second": {"TS", "JS", "QS", "KS", "AS"},
+			})
+
+			var pokerError *pokererr.Error
+			if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeInvalidHoleCardCount {
+				t.Fatalf("Expected error code %s, but got %v", pokererr.CodeInvalidHoleCardCount, err)
+			}
+			if pokerError.Data["hand"] != "first" || pokerError.Data["count"] != len(test.cards) {
+				t.Errorf("Expected the hand and its card count in the data, but got %v", pokerError.Data)
+			}
+		})
+	}
+}
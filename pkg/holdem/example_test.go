@@ -0,0 +1,47 @@
+package holdem_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devandreyl/go-poker-hands-evaluator/pkg/holdem"
+)
+
+func ExampleEvaluateHoldemHands() {
+	result, err := holdem.EvaluateHoldemHands([]string{"AS", "KD", "7C", "2H", "9S"}, holdem.Hands{
+		"first":  {"AH", "KC"},
+		"second": {"7D", "7S"},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(result.Winners, result.Result["second"].CombinationName)
+	// Output: [second] Three of a kind
+}
+
+func ExampleEvaluateGame() {
+	result, err := holdem.EvaluateGame(holdem.GameOmaha, []string{"2H", "5H", "9H", "JH", "KC"}, holdem.Hands{
+		"first":  {"AH", "AC", "AD", "7S"},
+		"second": {"TH", "8H", "3C", "4D"},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(result.Winners, result.Result["second"].BestCards)
+	// Output: [second] [TH 8H 5H 9H JH]
+}
+
+func ExampleCalculateEquity() {
+	result, err := holdem.CalculateEquity(context.Background(), holdem.EquityRequest{
+		Hands: holdem.Hands{"first": {"AH", "AD"}, "second": {"QH", "JH"}},
+		Board: []string{"AS", "KD", "7C", "2H"},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%d boards, second wins %.2f%%\n", result.Boards, result.Hands["second"].Win)
+	// Output: 44 boards, second wins 9.09%
+}
@@ -20,10 +20,24 @@ const (
 type Hands map[string][]string
 
 type HandResult struct {
-	HandName          string `json:"handName"`
-	CombinationName   string `json:"combinationName"`
-	HandWeight        int32  `json:"handWeight"`
-	CombinationWeight int32  `json:"combinationWeight"`
+	HandName          string   `json:"handName"`
+	CombinationName   string   `json:"combinationName"`
+	HandWeight        int32    `json:"handWeight"`
+	CombinationWeight int32    `json:"combinationWeight"`
+	Rank              HandRank `json:"rank"`
+	// BestCards are the five cards that formed the combination in order of significance, e.g. the pair first and
+	// then the kickers from the highest. Omaha hands list the two hole cards first.
+	BestCards []string `json:"bestCards,omitempty"`
+	// Kickers are the cards of BestCards that are not part of the combination but decide between equal combinations.
+	Kickers []string `json:"kickers,omitempty"`
+	// Description is the human-readable description of the hand, e.g. "Two Pair, Kings and Sevens, Ace kicker".
+	Description string `json:"description,omitempty"`
+	// WildCards tell what every wild card of the combination played as.
+	WildCards []WildCard `json:"wildCards,omitempty"`
+
+	// played are BestCards in the same order the way they count in the combination, e.g. with wild cards replaced.
+	played []Card
+	low    bool
 }
 
 type Hand struct {
@@ -35,7 +49,18 @@ type Hand struct {
 // This method has constant time complexity because it calls individual methods for each possible hand combination,
 // but it's not dependent on the size of the input (number of cards).
 // Each of the individual methods either returns a result or nil, so the overall complexity is constant.
+// The result carries a Rank that orders any two hands exactly, kickers included.
 func (h *Hand) DefineCombination() *HandResult {
+	result := h.defineCombination()
+	if result != nil {
+		result.Rank = h.calculateRank(result.CombinationWeight)
+		result.explain(h.Cards, h.Cards, h.resolveWeights())
+	}
+
+	return result
+}
+
+func (h *Hand) defineCombination() *HandResult {
 	if royalFlushResult := h.isRoyalFlush(); royalFlushResult != nil {
 		return royalFlushResult
 	}
@@ -86,6 +111,15 @@ func (h *Hand) calculateHandWeight() CardWeight {
 	return totalWeight
 }
 
+func (h *Hand) resolveWeights() []CardWeight {
+	weights := make([]CardWeight, len(h.Cards))
+	for i, card := range h.Cards {
+		weights[i] = card.ResolveWeight()
+	}
+
+	return weights
+}
+
 // isRoyalFlush Complexity: O(n) (linear time)
 // This method checks whether the cards have the same suit and whether their names match the royal flush combination.
 // It iterates through all the cards in the hand to perform these checks.
@@ -103,9 +137,14 @@ func (h *Hand) isRoyalFlush() *HandResult {
 		return nil
 	}
 
+	cardNames := make(map[CardName]bool, len(h.Cards))
+	for _, card := range h.Cards {
+		cardNames[card.Name] = true
+	}
+
 	royalFlushCombination := []CardName{"T", "J", "Q", "K", "A"}
-	for i, cardName := range royalFlushCombination {
-		if i >= len(h.Cards) || h.Cards[i].Name != cardName {
+	for _, cardName := range royalFlushCombination {
+		if !cardNames[cardName] {
 			return nil
 		}
 	}
@@ -118,11 +157,9 @@ func (h *Hand) isRoyalFlush() *HandResult {
 	}
 }
 
-// isStraightFlush Complexity: O(n log n) (linearithmic time)
-// The method involves sorting cards by weight before checking for a straight flush.
-// Sorting takes O(n log n) time in the worst case, and then the method iterates through the sorted cards, which is linear.
-// Possible optimization: Can avoid sorting the potential suit cards if the last card in the sequence is not an Ace.
-// This is because a straight flush can't be formed in this case, given that the Ace can't be the lower card in a sequence.
+// isStraightFlush Complexity: O(n) (linear time)
+// The method collects cards of the suit that occurs at least five times and looks for five consecutive weights among them.
+// Ace is also checked as the lowest card, so A-2-3-4-5 of one suit is a straight flush as well.
 func (h *Hand) isStraightFlush() *HandResult {
 	suitCount := make(map[CardSuit]int)
 
@@ -148,29 +185,18 @@ func (h *Hand) isStraightFlush() *HandResult {
 			potentialSuitCards = append(potentialSuitCards, card)
 		}
 	}
-	sort.Slice(potentialSuitCards, func(i, j int) bool {
-		return potentialSuitCards[i].Weight < potentialSuitCards[j].Weight
-	})
 
-	// Check if there is a sequence of five consecutive cards
-	consecutiveCount := 1
-	for i := 1; i < len(potentialSuitCards); i++ {
-		if potentialSuitCards[i].Weight-potentialSuitCards[i-1].Weight == 1 {
-			consecutiveCount++
-			if consecutiveCount == 5 {
-				return &HandResult{
-					HandName:          h.Name,
-					CombinationName:   "Straight Flush",
-					HandWeight:        int32(h.calculateHandWeight()),
-					CombinationWeight: straightFlushCombinationWeight,
-				}
-			}
-		} else {
-			consecutiveCount = 1
-		}
+	suitHand := Hand{Cards: potentialSuitCards}
+	if suitHand.straightHighCard() == 0 {
+		return nil
 	}
 
-	return nil
+	return &HandResult{
+		HandName:          h.Name,
+		CombinationName:   "Straight Flush",
+		HandWeight:        int32(h.calculateHandWeight()),
+		CombinationWeight: straightFlushCombinationWeight,
+	}
 }
 
 // isFullHouse Complexity: O(n) (linear time)
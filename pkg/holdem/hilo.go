@@ -0,0 +1,100 @@
+package holdem
+
+import (
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+// HiLoResult is the result of a split pot game. High and Low are ranked separately, hands without a qualifying low
+// are missing from Low, and Low has no winners when nobody qualifies.
+type HiLoResult struct {
+	High *EvaluateResult `json:"high"`
+	Low  *EvaluateResult `json:"low"`
+	// Shares are the parts of the pot every hand wins, from 0 to 1. A hand that wins the high alone and splits
+	// the low with one more hand gets three quarters of the pot.
+	Shares map[string]float64 `json:"shares"`
+}
+
+// EvaluateOmahaHiLoHands evaluates Omaha Hi-Lo (Omaha-8) hands with four or five hole cards. Both the high and the low
+// must use exactly two hole cards and three board cards, which may be different for the high and the low.
+// The low must be eight or better, when nobody qualifies the high takes the whole pot.
+func EvaluateOmahaHiLoHands(board []string, hands Hands, holeCardsCount int) (*HiLoResult, error) {
+	high, err := EvaluateOmahaHands(board, hands, holeCardsCount)
+	if err != nil {
+		return nil, err
+	}
+
+	boardCards, handsWithCards, err := parseDeal(board, hands.Seats())
+	if err != nil {
+		return nil, err
+	}
+
+	lowCombinations := make([]*HandResult, 0, len(handsWithCards))
+
+	for _, hand := range handsWithCards {
+		if low := hand.bestOmahaOf(boardCards, (*Hand).DefineEightOrBetterLow); low != nil {
+			lowCombinations = append(lowCombinations, low)
+		}
+	}
+
+	low := newEvaluateResult(lowCombinations)
+
+	return &HiLoResult{
+		High:   high,
+		Low:    low,
+		Shares: splitShares(high.Winners, low.Winners),
+	}, nil
+}
+
+// SplitPot Complexity: O(n) (linear time)
+// Divides the pot in chips. The pot is halved between the high and the low, an odd chip goes to the high half.
+// Each half is divided evenly between its winners and odd chips go to the winners in ranking order.
+// Without low winners the high winners take the whole pot.
+func (r *HiLoResult) SplitPot(pot int64) (map[string]int64, error) {
+	if pot < 0 {
+		return nil, pokererr.NewError(pokererr.CodeInvalidPot, pokererr.Data{"pot": pot})
+	}
+
+	chips := make(map[string]int64)
+	if len(r.Low.Winners) == 0 {
+		divideChips(chips, pot, r.High.Winners)
+		return chips, nil
+	}
+
+	lowHalf := pot / 2
+	divideChips(chips, pot-lowHalf, r.High.Winners)
+	divideChips(chips, lowHalf, r.Low.Winners)
+
+	return chips, nil
+}
+
+func splitShares(highWinners, lowWinners []string) map[string]float64 {
+	shares := make(map[string]float64)
+
+	highPart := 1.0
+	if len(lowWinners) > 0 {
+		highPart = 0.5
+		for _, handName := range lowWinners {
+			shares[handName] += 0.5 / float64(len(lowWinners))
+		}
+	}
+	for _, handName := range highWinners {
+		shares[handName] += highPart / float64(len(highWinners))
+	}
+
+	return shares
+}
+
+func divideChips(chips map[string]int64, amount int64, winners []string) {
+	if len(winners) == 0 {
+		return
+	}
+
+	share := amount / int64(len(winners))
+	oddChips := amount % int64(len(winners))
+	for i, handName := range winners {
+		chips[handName] += share
+		if int64(i) < oddChips {
+			chips[handName]++
+		}
+	}
+}
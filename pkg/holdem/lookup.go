@@ -0,0 +1,211 @@
+package holdem
+
+import (
+	"sort"
+	"sync"
+)
+
+// PackedCard is a card packed into 32 bits the Cactus Kev way:
+//
+//	xxxbbbbb bbbbbbbb cdhsrrrr xxpppppp
+//
+// b is the bit of the card rank, cdhs is the bit of the suit, r is the rank index (deuce is 0, ace is 12)
+// and p is the prime number of the rank. The bit of the rank is used for flushes and straights,
+// the product of primes identifies the ranks of a hand with paired cards.
+type PackedCard uint32
+
+const (
+	packedRankBitPos  = 16
+	packedSuitMask    = 0xF000
+	packedSuitPos     = 12
+	packedRankPos     = 8
+	packedPrimeMask   = 0xFF
+	packedRankPattern = 1 << 13
+)
+
+var (
+	rankPrimes = [13]uint32{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41}
+	suitBits   = map[CardSuit]uint32{"S": 0x1, "H": 0x2, "D": 0x4, "C": 0x8}
+
+	// Indexes of all five-card subsets of six and seven cards.
+	sixCardsCombinations   [6][5]uint8
+	sevenCardsCombinations [21][5]uint8
+)
+
+// Lookup tables are built on the first evaluation from DefineCombination itself, so both evaluators always agree.
+var (
+	lookupTablesOnce sync.Once
+	// flushRanks are ranks of five suited cards of distinct weights indexed by the rank pattern.
+	flushRanks [packedRankPattern]HandRank
+	// uniqueRanks are ranks of five unsuited cards of distinct weights indexed by the rank pattern.
+	uniqueRanks [packedRankPattern]HandRank
+	// pairedProducts are sorted products of rank primes of hands with paired cards, pairedRanks are their ranks.
+	pairedProducts []uint32
+	pairedRanks    []HandRank
+)
+
+func init() {
+	fillCombinations(sixCardsCombinations[:], 6)
+	fillCombinations(sevenCardsCombinations[:], 7)
+}
+
+// PackCard packs a parsed card, see PackedCard for the layout. Cards outside of the standard deck, e.g. jokers,
+// are packed as zero and EvaluatePacked ranks every five cards with such a card as zero.
+func PackCard(card Card) PackedCard {
+	weight := card.ResolveWeight()
+	suit, ok := suitBits[card.Suit]
+	if weight == 0 || !ok {
+		return 0
+	}
+	rank := uint32(weight - 2)
+
+	return PackedCard(1<<(packedRankBitPos+rank) | suit<<packedSuitPos | rank<<packedRankPos | rankPrimes[rank])
+}
+
+// PackCards packs every card, see PackCard.
+func PackCards(cards []Card) []PackedCard {
+	packed := make([]PackedCard, len(cards))
+	for i, card := range cards {
+		packed[i] = PackCard(card)
+	}
+	return packed
+}
+
+// EvaluatePacked Complexity: O(1) (constant time)
+// Returns the rank of the best five-card combination out of 5, 6 or 7 packed cards, or zero for any other count.
+// A five-card hand takes at most two table lookups and a binary search, six and seven cards are evaluated
+// as all their five-card subsets. The evaluation doesn't allocate.
+func EvaluatePacked(cards []PackedCard) HandRank {
+	lookupTablesOnce.Do(buildLookupTables)
+
+	switch len(cards) {
+	case 5:
+		return evaluate5(cards[0], cards[1], cards[2], cards[3], cards[4])
+	case 6:
+		return evaluateSubsets(cards, sixCardsCombinations[:])
+	case 7:
+		return evaluateSubsets(cards, sevenCardsCombinations[:])
+	}
+
+	return 0
+}
+
+func evaluateSubsets(cards []PackedCard, combinations [][5]uint8) HandRank {
+	var best HandRank
+	for _, c := range combinations {
+		if rank := evaluate5(cards[c[0]], cards[c[1]], cards[c[2]], cards[c[3]], cards[c[4]]); rank > best {
+			best = rank
+		}
+	}
+	return best
+}
+
+func evaluate5(c1, c2, c3, c4, c5 PackedCard) HandRank {
+	pattern := (c1 | c2 | c3 | c4 | c5) >> packedRankBitPos
+	if c1&c2&c3&c4&c5&packedSuitMask != 0 {
+		return flushRanks[pattern]
+	}
+	if rank := uniqueRanks[pattern]; rank != 0 {
+		return rank
+	}
+
+	product := uint32(c1&packedPrimeMask) * uint32(c2&packedPrimeMask) * uint32(c3&packedPrimeMask) *
+		uint32(c4&packedPrimeMask) * uint32(c5&packedPrimeMask)
+
+	low, high := 0, len(pairedProducts)
+	for low < high {
+		middle := int(uint(low+high) >> 1)
+		if pairedProducts[middle] < product {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+	if low < len(pairedProducts) && pairedProducts[low] == product {
+		return pairedRanks[low]
+	}
+
+	return 0
+}
+
+// buildLookupTables Complexity: O(1) (constant time)
+// Walks through all 6175 multisets of five ranks (at most four cards of a rank) once and evaluates each of them
+// with DefineCombination: distinct ranks both as a flush and as an offsuit hand, paired ranks as an offsuit hand.
+func buildLookupTables() {
+	suits := cardSuitsList
+	counts := make([]int, len(cardsList))
+	type pairedRank struct {
+		product uint32
+		rank    HandRank
+	}
+	var paired []pairedRank
+
+	var walk func(rank, left int)
+	walk = func(rank, left int) {
+		if left == 0 {
+			var (
+				flush, offsuit Hand
+				pattern        int
+				product        uint32 = 1
+				distinct              = true
+			)
+			for r, count := range counts {
+				for i := 0; i < count; i++ {
+					card := Card{Name: cardsList[r], Suit: suits[i], Weight: ResolveWeight(cardsList[r])}
+					flush.Cards = append(flush.Cards, Card{Name: card.Name, Suit: suits[0], Weight: card.Weight})
+					offsuit.Cards = append(offsuit.Cards, card)
+					product *= rankPrimes[r]
+				}
+				if count > 0 {
+					pattern |= 1 << r
+				}
+				distinct = distinct && count <= 1
+			}
+
+			if distinct {
+				// The last card gets another suit to break the flush.
+				offsuit.Cards[len(offsuit.Cards)-1].Suit = suits[1]
+				flushRanks[pattern] = flush.DefineCombination().Rank
+				uniqueRanks[pattern] = offsuit.DefineCombination().Rank
+				return
+			}
+
+			paired = append(paired, pairedRank{product: product, rank: offsuit.DefineCombination().Rank})
+			return
+		}
+		if rank == len(cardsList) {
+			return
+		}
+
+		for count := left; count >= 0; count-- {
+			if count > len(suits) {
+				continue
+			}
+			counts[rank] = count
+			walk(rank+1, left-count)
+		}
+		counts[rank] = 0
+	}
+	walk(0, combinationCardsCount)
+
+	sort.Slice(paired, func(i, j int) bool {
+		return paired[i].product < paired[j].product
+	})
+
+	pairedProducts = make([]uint32, len(paired))
+	pairedRanks = make([]HandRank, len(paired))
+	for i, p := range paired {
+		pairedProducts[i] = p.product
+		pairedRanks[i] = p.rank
+	}
+}
+
+func fillCombinations(combinations [][5]uint8, n int) {
+	i := 0
+	forEachCombination(n, combinationCardsCount, func(indexes []int) {
+		for j, index := range indexes {
+			combinations[i][j] = uint8(index)
+		}
+		i++
+	})
+}
@@ -0,0 +1,144 @@
+package holdem
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestEvaluatePacked_MatchesDefineCombination(t *testing.T) {
+	deck := NewDeck()
+	random := rand.New(rand.NewSource(1))
+
+	for _, size := range []int{5, 6, 7} {
+		iterations := 50000
+		if size > 5 {
+			iterations = 5000
+		}
+
+		for i := 0; i < iterations; i++ {
+			random.Shuffle(len(deck), func(i, j int) {
+				deck[i], deck[j] = deck[j], deck[i]
+			})
+			hand := Hand{Cards: append([]Card{}, deck[:size]...)}
+
+			expected := hand.BestCombination()
+			rank := EvaluatePacked(PackCards(hand.Cards))
+
+			if rank.CombinationWeight() != expected.CombinationWeight || rank != expected.Rank {
+				t.Fatalf("Hand %v: expected rank %d (%s), but got %d", hand.Cards, expected.Rank, expected.CombinationName, rank)
+			}
+		}
+	}
+}
+
+func TestPackCard_OutsideOfDeck(t *testing.T) {
+	for _, card := range []Card{Joker, {Name: "1", Suit: "S"}, {Name: "A", Suit: "X"}} {
+		if packed := PackCard(card); packed != 0 {
+			t.Errorf("Expected %v to be packed as zero, but got %d", card, packed)
+		}
+	}
+
+	hand := PackCards([]Card{Joker, {Name: "A", Suit: "S"}, {Name: "K", Suit: "S"}, {Name: "Q", Suit: "S"}, {Name: "J", Suit: "S"}})
+	if rank := EvaluatePacked(hand); rank != 0 {
+		t.Errorf("Expected zero rank of a hand with a joker, but got %d", rank)
+	}
+}
+
+func TestEvaluatePacked_AllFiveCardHands(t *testing.T) {
+	deck := PackCards(NewDeck())
+	combinationsCount := map[int32]int{}
+
+	forEachCombination(len(deck), 5, func(indexes []int) {
+		rank := EvaluatePacked([]PackedCard{deck[indexes[0]], deck[indexes[1]], deck[indexes[2]], deck[indexes[3]], deck[indexes[4]]})
+		combinationsCount[rank.CombinationWeight()]++
+	})
+
+	expected := map[int32]int{
+		royalFlushCombinationWeight:    4,
+		straightFlushCombinationWeight: 36,
+		fourOfAKindCombinationWeight:   624,
+		fullHouseCombinationWeight:     3744,
+		flushCombinationWeight:         5108,
+		straightCombinationWeight:      10200,
+		threeOfAKindCombinationWeight:  54912,
+		twoPairCombinationWeight:       123552,
+		pairCombinationWeight:          1098240,
+		highCardCombinationWeight:      1302540,
+	}
+	for combinationWeight, count := range expected {
+		if combinationsCount[combinationWeight] != count {
+			t.Errorf("Expected %d hands of combination weight %d, but got %d", count, combinationWeight, combinationsCount[combinationWeight])
+		}
+	}
+}
+
+func TestEvaluatePacked_Allocations(t *testing.T) {
+	cards := PackCards(benchmarkHands(1, 7)[0].Cards)
+	EvaluatePacked(cards)
+
+	if allocations := testing.AllocsPerRun(100, func() { EvaluatePacked(cards) }); allocations != 0 {
+		t.Errorf("Expected no allocations, but got %v", allocations)
+	}
+}
+
+func BenchmarkHand_DefineCombination(b *testing.B) {
+	hands := benchmarkHands(1024, 5)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		hands[i%len(hands)].DefineCombination()
+	}
+}
+
+func BenchmarkHand_BestCombination7(b *testing.B) {
+	hands := benchmarkHands(1024, 7)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		hands[i%len(hands)].BestCombination()
+	}
+}
+
+func BenchmarkEvaluatePacked5(b *testing.B) {
+	benchmarkEvaluatePacked(b, 5)
+}
+
+func BenchmarkEvaluatePacked6(b *testing.B) {
+	benchmarkEvaluatePacked(b, 6)
+}
+
+func BenchmarkEvaluatePacked7(b *testing.B) {
+	benchmarkEvaluatePacked(b, 7)
+}
+
+func benchmarkEvaluatePacked(b *testing.B, size int) {
+	hands := benchmarkHands(1024, size)
+	packed := make([][]PackedCard, len(hands))
+	for i, hand := range hands {
+		packed[i] = PackCards(hand.Cards)
+	}
+	EvaluatePacked(packed[0])
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		EvaluatePacked(packed[i%len(packed)])
+	}
+}
+
+func benchmarkHands(count, size int) []Hand {
+	deck := NewDeck()
+	random := rand.New(rand.NewSource(int64(count * size)))
+	hands := make([]Hand, count)
+
+	for i := range hands {
+		random.Shuffle(len(deck), func(i, j int) {
+			deck[i], deck[j] = deck[j], deck[i]
+		})
+		hands[i] = Hand{Cards: append([]Card{}, deck[:size]...)}
+	}
+
+	return hands
+}
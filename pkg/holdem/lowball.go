@@ -0,0 +1,233 @@
+package holdem
+
+import (
+	"sort"
+	"strings"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+type LowRule string
+
+const (
+	// AceToFive is the Razz and Omaha Hi-Lo low: aces are low, straights and flushes don't count, A-2-3-4-5 is the best hand.
+	AceToFive LowRule = "ace-to-five"
+	// DeuceToSeven is the Kansas City low: aces are high, straights and flushes count, 7-5-4-3-2 is the best hand.
+	DeuceToSeven LowRule = "deuce-to-seven"
+)
+
+const (
+	aceLowWeight CardWeight = 1
+	// Low ranks are inverted high ranks, so that a greater rank still always wins. The base is above any high rank.
+	lowRankBase HandRank = 1 << (rankCombinationPos + rankTieBreakBits)
+	// The highest card of a low that qualifies for the low half of a hi-lo pot.
+	eightOrBetterWeight CardWeight = 8
+)
+
+// LowCategory is the Category of all unpaired low hands.
+const LowCategory = "Low"
+
+// lowHandsRules are the card counts of EvaluateLowHands: five to seven cards of every hand and no board.
+var lowHandsRules = Rules{HoleCards: CardCount{Min: combinationCardsCount, Max: 7}}
+
+var lowRules = map[LowRule]func(h *Hand) *HandResult{
+	AceToFive:    (*Hand).DefineAceToFiveLow,
+	DeuceToSeven: (*Hand).DefineDeuceToSevenLow,
+}
+
+// Category returns the combination name of the hand, except for unpaired low hands: their names list their cards,
+// e.g. "7-5-4-3-A low", so they all share LowCategory. Unlike combination names, categories are a small fixed set,
+// e.g. for statistics of evaluated hands.
+func (r *HandResult) Category() string {
+	if r.low && r.CombinationWeight == highCardCombinationWeight {
+		return LowCategory
+	}
+
+	return r.CombinationName
+}
+
+// EvaluateLowHands evaluates lowball hands of five to seven cards by the given rule, every hand plays its best five cards.
+// Ranking, winners and ties have the same meaning as for high hands: the best low takes the first place.
+func EvaluateLowHands(hands Hands, rule LowRule) (*EvaluateResult, error) {
+	define, ok := lowRules[rule]
+	if !ok {
+		return nil, pokererr.NewError(pokererr.CodeUnsupportedGame, pokererr.Data{"game": rule})
+	}
+
+	seats := hands.Seats()
+	if err := validateRules(lowHandsRules, nil, seats); err != nil {
+		return nil, err
+	}
+
+	_, handsWithCards, err := parseDeal(nil, seats)
+	if err != nil {
+		return nil, err
+	}
+
+	handCombinations := make([]*HandResult, 0, len(handsWithCards))
+
+	for _, hand := range handsWithCards {
+		handCombinations = append(handCombinations, hand.bestOf(define))
+	}
+
+	return newEvaluateResult(handCombinations), nil
+}
+
+// DefineAceToFiveLow Complexity: O(n log n) (linearithmic time)
+// Ranks five cards as an ace-to-five low. Aces are the lowest cards, straights and flushes are ignored,
+// so hands without pairs are compared by their highest card, then by the next one and so on.
+// Any hand without pairs beats any paired hand. The result Rank is inverted, the best low has the greatest rank.
+func (h *Hand) DefineAceToFiveLow() *HandResult {
+	if len(h.Cards) != combinationCardsCount {
+		return nil
+	}
+
+	weights := make([]CardWeight, len(h.Cards))
+	for i, card := range h.Cards {
+		weights[i] = card.ResolveWeight()
+		if weights[i] == cardWeights["A"] {
+			weights[i] = aceLowWeight
+		}
+	}
+
+	combinationWeight, combinationName := groupCombination(weights)
+	highRank := newHandRank(combinationWeight, groupTieBreak(weights))
+
+	return h.newLowResult(combinationWeight, combinationName, highRank, weights)
+}
+
+// DefineDeuceToSevenLow Complexity: O(n log n) (linearithmic time)
+// Ranks five cards as a deuce-to-seven low, which is exactly the reverse of the high hand ranking, except that aces
+// are always high, so A-2-3-4-5 is not a straight. The result Rank is inverted, the best low has the greatest rank.
+func (h *Hand) DefineDeuceToSevenLow() *HandResult {
+	if len(h.Cards) != combinationCardsCount {
+		return nil
+	}
+
+	high := h.DefineCombination()
+	if high == nil {
+		return nil
+	}
+
+	if (high.CombinationWeight == straightCombinationWeight || high.CombinationWeight == straightFlushCombinationWeight) &&
+		h.straightHighCard() == cardWeights["5"] {
+		if high.CombinationWeight == straightFlushCombinationWeight {
+			high.CombinationWeight, high.CombinationName = flushCombinationWeight, "Flush"
+		} else {
+			high.CombinationWeight, high.CombinationName = highCardCombinationWeight, "High card"
+		}
+		high.Rank = h.calculateRank(high.CombinationWeight)
+	}
+
+	weights := make([]CardWeight, len(h.Cards))
+	for i, card := range h.Cards {
+		weights[i] = card.ResolveWeight()
+	}
+
+	return h.newLowResult(high.CombinationWeight, high.CombinationName, high.Rank, weights)
+}
+
+// DefineEightOrBetterLow Complexity: O(n log n) (linearithmic time)
+// Returns the ace-to-five low of five cards only when it qualifies for the low half of a hi-lo pot:
+// five cards of different ranks, eight or lower.
+func (h *Hand) DefineEightOrBetterLow() *HandResult {
+	low := h.DefineAceToFiveLow()
+	if low == nil || low.CombinationWeight != highCardCombinationWeight {
+		return nil
+	}
+
+	for _, card := range h.Cards {
+		if weight := card.ResolveWeight(); weight != cardWeights["A"] && weight > eightOrBetterWeight {
+			return nil
+		}
+	}
+
+	return low
+}
+
+// BestAceToFiveLow picks the best ace-to-five low out of five to seven cards, e.g. in Razz.
+func (h *Hand) BestAceToFiveLow() *HandResult {
+	return h.bestOf((*Hand).DefineAceToFiveLow)
+}
+
+// BestDeuceToSevenLow picks the best deuce-to-seven low out of five to seven cards.
+func (h *Hand) BestDeuceToSevenLow() *HandResult {
+	return h.bestOf((*Hand).DefineDeuceToSevenLow)
+}
+
+func (h *Hand) newLowResult(
+	combinationWeight int32,
+	combinationName string,
+	highRank HandRank,
+	weights []CardWeight,
+) *HandResult {
+	if combinationWeight == highCardCombinationWeight {
+		combinationName = lowCombinationName(weights)
+	}
+
+	result := &HandResult{
+		HandName:          h.Name,
+		CombinationName:   combinationName,
+		HandWeight:        int32(h.calculateHandWeight()),
+		CombinationWeight: combinationWeight,
+		Rank:              lowRankBase - highRank,
+		low:               true,
+	}
+	result.explain(h.Cards, h.Cards, weights)
+
+	return result
+}
+
+// groupCombination names the combination of paired cards, straights and flushes are not considered.
+func groupCombination(weights []CardWeight) (int32, string) {
+	counts := make(map[CardWeight]int)
+	for _, weight := range weights {
+		counts[weight]++
+	}
+
+	var pairs, trips, quads int
+	for _, count := range counts {
+		switch count {
+		case 2:
+			pairs++
+		case 3:
+			trips++
+		case 4:
+			quads++
+		}
+	}
+
+	switch {
+	case quads > 0:
+		return fourOfAKindCombinationWeight, "Four of a kind"
+	case trips > 0 && pairs > 0:
+		return fullHouseCombinationWeight, "Full House"
+	case trips > 0:
+		return threeOfAKindCombinationWeight, "Three of a kind"
+	case pairs > 1:
+		return twoPairCombinationWeight, "Two pair"
+	case pairs > 0:
+		return pairCombinationWeight, "Pair"
+	}
+
+	return highCardCombinationWeight, "High card"
+}
+
+// lowCombinationName lists cards from the highest, e.g. "7-5-4-3-A low".
+func lowCombinationName(weights []CardWeight) string {
+	sorted := append([]CardWeight{}, weights...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] > sorted[j]
+	})
+
+	names := make([]string, len(sorted))
+	for i, weight := range sorted {
+		if weight == aceLowWeight {
+			names[i] = "A"
+			continue
+		}
+		names[i] = string(cardsList[weight-2])
+	}
+
+	return strings.Join(names, "-") + " low"
+}
@@ -0,0 +1,297 @@
+package holdem
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+func TestHand_DefineAceToFiveLow(t *testing.T) {
+	tests := []struct {
+		name         string
+		winner       []string
+		loser        []string
+		expectedName string
+	}{
+		{
+			name:         "Wheel is the best low despite being a straight",
+			winner:       []string{"AH", "2H", "3H", "4H", "5H"},
+			loser:        []string{"AC", "2D", "3S", "4C", "6D"},
+			expectedName: "5-4-3-2-A low",
+		},
+		{
+			name:         "Lows are compared from the highest card",
+			winner:       []string{"7H", "5C", "4D", "3S", "2H"},
+			loser:        []string{"7C", "6D", "3C", "2C", "AD"},
+			expectedName: "7-5-4-3-2 low",
+		},
+		{
+			name:         "Any unpaired hand beats a pair",
+			winner:       []string{"KH", "QC", "JD", "9S", "8H"},
+			loser:        []string{"AH", "AC", "2D", "3S", "4H"},
+			expectedName: "K-Q-J-9-8 low",
+		},
+		{
+			name:         "Lower pair wins",
+			winner:       []string{"AH", "AC", "KD", "QS", "JH"},
+			loser:        []string{"2H", "2C", "3D", "4S", "5C"},
+			expectedName: "Pair",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			winner := handFromStrings(t, test.winner).DefineAceToFiveLow()
+			loser := handFromStrings(t, test.loser).DefineAceToFiveLow()
+
+			if winner.Compare(loser) <= 0 {
+				t.Errorf("Expected %v to beat %v", winner, loser)
+			}
+			if winner.CombinationName != test.expectedName {
+				t.Errorf("Expected combination name %s, but got %s", test.expectedName, winner.CombinationName)
+			}
+		})
+	}
+}
+
+func TestHand_DefineDeuceToSevenLow(t *testing.T) {
+	tests := []struct {
+		name         string
+		winner       []string
+		loser        []string
+		expectedName string
+	}{
+		{
+			name:         "Seven-five is the best low",
+			winner:       []string{"7H", "5C", "4D", "3S", "2H"},
+			loser:        []string{"7C", "6D", "4C", "3C", "2D"},
+			expectedName: "7-5-4-3-2 low",
+		},
+		{
+			name:         "Straight counts against the hand",
+			winner:       []string{"8H", "7C", "6D", "5S", "3H"},
+			loser:        []string{"7C", "6D", "5C", "4C", "3D"},
+			expectedName: "8-7-6-5-3 low",
+		},
+		{
+			name:         "Flush counts against the hand",
+			winner:       []string{"KH", "QC", "JD", "9S", "8H"},
+			loser:        []string{"7H", "5H", "4H", "3H", "2H"},
+			expectedName: "K-Q-J-9-8 low",
+		},
+		{
+			name:         "Ace is high, so the wheel is just an ace-high hand",
+			winner:       []string{"KH", "QC", "JD", "9S", "8H"},
+			loser:        []string{"AC", "2D", "3S", "4C", "5D"},
+			expectedName: "K-Q-J-9-8 low",
+		},
+		{
+			name:         "Pair loses to any unpaired hand",
+			winner:       []string{"AH", "KC", "QD", "JS", "9H"},
+			loser:        []string{"2H", "2C", "3D", "4S", "5C"},
+			expectedName: "A-K-Q-J-9 low",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			winner := handFromStrings(t, test.winner).DefineDeuceToSevenLow()
+			loser := handFromStrings(t, test.loser).DefineDeuceToSevenLow()
+
+			if winner.Compare(loser) <= 0 {
+				t.Errorf("Expected %v to beat %v", winner, loser)
+			}
+			if winner.CombinationName != test.expectedName {
+				t.Errorf("Expected combination name %s, but got %s", test.expectedName, winner.CombinationName)
+			}
+		})
+	}
+}
+
+func TestHand_DefineEightOrBetterLow(t *testing.T) {
+	tests := []struct {
+		name      string
+		cards     []string
+		qualifies bool
+	}{
+		{name: "Eight low", cards: []string{"8H", "5C", "4D", "3S", "AH"}, qualifies: true},
+		{name: "Wheel", cards: []string{"5H", "4C", "3D", "2S", "AH"}, qualifies: true},
+		{name: "Nine low", cards: []string{"9H", "5C", "4D", "3S", "AH"}, qualifies: false},
+		{name: "Paired", cards: []string{"8H", "8C", "4D", "3S", "AH"}, qualifies: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			low := handFromStrings(t, test.cards).DefineEightOrBetterLow()
+			if (low != nil) != test.qualifies {
+				t.Errorf("Expected qualification %v, but got %v", test.qualifies, low)
+			}
+		})
+	}
+}
+
+func TestEvaluateLowHands_Razz(t *testing.T) {
+	result, err := EvaluateLowHands(Hands{
+		"first":  {"AH", "2C", "2D", "5S", "7H", "KC", "KD"},
+		"second": {"3H", "4C", "6D", "7S", "8C", "9D", "TC"},
+	}, AceToFive)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(result.Winners, []string{"second"}) {
+		t.Errorf("Expected the second hand to win, but got %v", result.Winners)
+	}
+	if name := result.Result["first"].CombinationName; name != "K-7-5-2-A low" {
+		t.Errorf("Expected K-7-5-2-A low, but got %s", name)
+	}
+	if name := result.Result["second"].CombinationName; name != "8-7-6-4-3 low" {
+		t.Errorf("Expected 8-7-6-4-3 low, but got %s", name)
+	}
+}
+
+func TestEvaluateLowHands_InvalidCardCount(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []string
+	}{
+		{name: "Four cards", cards: []string{"AH", "2C", "3D", "4S"}},
+		{name: "Eight cards", cards: []string{"AH", "2C", "3D", "4S", "5H", "6C", "7D", "8S"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := EvaluateLowHands(Hands{
+				"first":  test.cards,
+				"second": {"3H", "4C", "6D", "7S", "8C"},
+			}, AceToFive)
+
+			var pokerError *pokererr.Error
+			if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeInvalidHoleCardCount {
+				t.Fatalf("Expected error code %s, but got %v", pokererr.CodeInvalidHoleCardCount, err)
+			}
+			if pokerError.Data["hand"] != "first" || pokerError.Data["count"] != len(test.cards) {
+				t.Errorf("Expected the first hand with %d cards, but got %v", len(test.cards), pokerError.Data)
+			}
+		})
+	}
+}
+
+func TestEvaluateOmahaHiLoHands(t *testing.T) {
+	tests := []struct {
+		name           string
+		board          []string
+		hands          Hands
+		expectedShares map[string]float64
+		expectedChips  map[string]int64
+	}{
+		{
+			name:  "Scoop without a qualifying low",
+			board: []string{"KH", "QD", "9C", "9S", "TH"},
+			hands: Hands{
+				"first":  {"AS", "2S", "3D", "JC"},
+				"second": {"KC", "KD", "4H", "5H"},
+			},
+			expectedShares: map[string]float64{"second": 1},
+			expectedChips:  map[string]int64{"second": 101},
+		},
+		{
+			name:  "High and low go to different hands",
+			board: []string{"2H", "5D", "8C", "KS", "KD"},
+			hands: Hands{
+				"first":  {"AS", "3S", "JC", "QC"},
+				"second": {"KC", "8D", "9H", "TH"},
+			},
+			expectedShares: map[string]float64{"first": 0.5, "second": 0.5},
+			expectedChips:  map[string]int64{"first": 50, "second": 51},
+		},
+		{
+			name:  "Quartered low",
+			board: []string{"2H", "5D", "8C", "KS", "QD"},
+			hands: Hands{
+				"first":  {"AS", "3S", "KC", "KH"},
+				"second": {"AD", "3C", "JC", "TH"},
+			},
+			expectedShares: map[string]float64{"first": 0.75, "second": 0.25},
+			expectedChips:  map[string]int64{"first": 76, "second": 25},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := EvaluateOmahaHiLoHands(test.board, test.hands, OmahaHoleCardsCount)
+			if err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+
+			if !reflect.DeepEqual(result.Shares, test.expectedShares) {
+				t.Errorf("Expected shares %v, but got %v", test.expectedShares, result.Shares)
+			}
+
+			chips, err := result.SplitPot(101)
+			if err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+			if !reflect.DeepEqual(chips, test.expectedChips) {
+				t.Errorf("Expected chips %v, but got %v", test.expectedChips, chips)
+			}
+		})
+	}
+}
+
+func handFromStrings(t *testing.T, cardStrings []string) *Hand {
+	t.Helper()
+
+	cards, err := ParseCards(cardStrings)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	return &Hand{Name: "Test Hand", Cards: cards}
+}
+
+func TestHandResult_Category(t *testing.T) {
+	tests := []struct {
+		name     string
+		cards    []string
+		define   func(h *Hand) *HandResult
+		expected string
+	}{
+		{
+			name:     "High hand",
+			cards:    []string{"KH", "KC", "7D", "7S", "2H"},
+			define:   (*Hand).DefineCombination,
+			expected: "Two pair",
+		},
+		{
+			name:     "Unpaired ace-to-five low",
+			cards:    []string{"7H", "5C", "4D", "3S", "AH"},
+			define:   (*Hand).DefineAceToFiveLow,
+			expected: LowCategory,
+		},
+		{
+			name:     "Unpaired deuce-to-seven low",
+			cards:    []string{"7H", "5C", "4D", "3S", "2H"},
+			define:   (*Hand).DefineDeuceToSevenLow,
+			expected: LowCategory,
+		},
+		{
+			name:     "Paired low",
+			cards:    []string{"AH", "AC", "KD", "QS", "JH"},
+			define:   (*Hand).DefineAceToFiveLow,
+			expected: "Pair",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.define(handFromStrings(t, test.cards))
+
+			if category := result.Category(); category != test.expected {
+				t.Errorf("Expected category %s, but got %s", test.expected, category)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package holdem
+
+import (
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+const (
+	OmahaHoleCardsCount  = 4
+	Omaha5HoleCardsCount = 5
+
+	omahaHoleCardsUsed  = 2
+	omahaBoardCardsUsed = 3
+)
+
+// EvaluateOmahaHands evaluates Omaha hands with four (PLO4) or five (PLO5) hole cards per hand and the shared board
+// of three to five cards. Unlike Hold'em, every hand must use exactly two of its hole cards and exactly three board cards.
+func EvaluateOmahaHands(board []string, hands Hands, holeCardsCount int) (*EvaluateResult, error) {
+	if holeCardsCount != OmahaHoleCardsCount && holeCardsCount != Omaha5HoleCardsCount {
+		return nil, pokererr.NewError(pokererr.CodeInvalidHoleCardCount, pokererr.Data{
+			"count": holeCardsCount,
+			"min":   OmahaHoleCardsCount,
+			"max":   Omaha5HoleCardsCount,
+		})
+	}
+
+	seats := hands.Seats()
+	if err := validateBoardDeal(board, seats, holeCardsCount); err != nil {
+		return nil, err
+	}
+
+	boardCards, handsWithCards, err := parseDeal(board, seats)
+	if err != nil {
+		return nil, err
+	}
+
+	handCombinations := make([]*HandResult, 0, len(handsWithCards))
+
+	for _, hand := range handsWithCards {
+		handCombinations = append(handCombinations, hand.BestOmahaCombination(boardCards))
+	}
+
+	return newEvaluateResult(handCombinations), nil
+}
+
+// BestOmahaCombination Complexity: O(C(h, 2) * C(b, 3)) (binomial)
+// Every pair of hole cards is combined with every three board cards, it's 60 combinations for four hole cards
+// and a full board and 100 for five hole cards. The combination with the highest rank is kept
+// and its cards are reported in BestCards, the two hole cards first.
+func (h *Hand) BestOmahaCombination(board []Card) *HandResult {
+	return h.bestOmahaOf(board, (*Hand).DefineCombination)
+}
+
+// bestOmahaOf evaluates every two hole cards with every three board cards by the given rules
+// and keeps the result with the highest rank.
+func (h *Hand) bestOmahaOf(board []Card, define func(h *Hand) *HandResult) *HandResult {
+	var best *HandResult
+
+	forEachCombination(len(h.Cards), omahaHoleCardsUsed, func(holeIndexes []int) {
+		holeCards := pickCards(h.Cards, holeIndexes)
+
+		forEachCombination(len(board), omahaBoardCardsUsed, func(boardIndexes []int) {
+			candidate := Hand{
+				Name:  h.Name,
+				Cards: append(append(make([]Card, 0, combinationCardsCount), holeCards...), pickCards(board, boardIndexes)...),
+			}
+
+			result := define(&candidate)
+			if result != nil && (best == nil || result.Rank > best.Rank) {
+				result.BestCards = cardStrings(candidate.Cards)
+				best = result
+			}
+		})
+	})
+
+	return best
+}
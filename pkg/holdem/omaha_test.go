@@ -0,0 +1,123 @@
+package holdem
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+func TestEvaluateOmahaHands(t *testing.T) {
+	tests := []struct {
+		name              string
+		board             []string
+		hands             Hands
+		holeCardsCount    int
+		expectedWinners   []string
+		expectedNames     map[string]string
+		expectedBestCards map[string][]string
+	}{
+		{
+			name:  "Flush needs two suited hole cards and a third ace can't play",
+			board: []string{"2H", "5H", "9H", "JH", "KC"},
+			hands: Hands{
+				"first":  {"AH", "AC", "AD", "7S"},
+				"second": {"TH", "8H", "3C", "4D"},
+			},
+			holeCardsCount:  OmahaHoleCardsCount,
+			expectedWinners: []string{"second"},
+			expectedNames: map[string]string{
+				"first":  "Pair",
+				"second": "Flush",
+			},
+			expectedBestCards: map[string][]string{
+				"first":  {"AH", "AC", "9H", "JH", "KC"},
+				"second": {"TH", "8H", "5H", "9H", "JH"},
+			},
+		},
+		{
+			name:  "Four of a kind in the hand plays as a pair",
+			board: []string{"2C", "7D", "9S", "JH", "3D"},
+			hands: Hands{
+				"first":  {"KH", "KC", "KD", "KS"},
+				"second": {"9C", "9D", "2D", "4S"},
+			},
+			holeCardsCount:  OmahaHoleCardsCount,
+			expectedWinners: []string{"second"},
+			expectedNames: map[string]string{
+				"first":  "Pair",
+				"second": "Three of a kind",
+			},
+			expectedBestCards: map[string][]string{
+				"first":  {"KH", "KC", "9S", "JH", "7D"},
+				"second": {"9C", "9D", "9S", "JH", "7D"},
+			},
+		},
+		{
+			name:  "Five hole cards",
+			board: []string{"TS", "JS", "QD"},
+			hands: Hands{
+				"first":  {"AS", "KS", "2C", "3C", "4C"},
+				"second": {"QS", "QC", "TD", "TC", "5H"},
+			},
+			holeCardsCount:  Omaha5HoleCardsCount,
+			expectedWinners: []string{"first"},
+			expectedNames: map[string]string{
+				"first":  "Straight",
+				"second": "Three of a kind",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := EvaluateOmahaHands(test.board, test.hands, test.holeCardsCount)
+			if err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+
+			for handName, expectedName := range test.expectedNames {
+				if got := result.Result[handName].CombinationName; got != expectedName {
+					t.Errorf("Expected %s for %s, but got %s", expectedName, handName, got)
+				}
+			}
+			for handName, expectedCards := range test.expectedBestCards {
+				if !sameCards(result.Result[handName].BestCards, expectedCards) {
+					t.Errorf("Expected best cards %v for %s, but got %v", expectedCards, handName, result.Result[handName].BestCards)
+				}
+			}
+			if !reflect.DeepEqual(result.Winners, test.expectedWinners) {
+				t.Errorf("Expected winners %v, but got %v", test.expectedWinners, result.Winners)
+			}
+		})
+	}
+}
+
+func TestEvaluateOmahaHands_InvalidHoleCardCount(t *testing.T) {
+	_, err := EvaluateOmahaHands([]string{"2C", "7D", "9S"}, Hands{"first": {"KH", "KC"}}, OmahaHoleCardsCount)
+
+	var pokerError *pokererr.Error
+	if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeInvalidHoleCardCount {
+		t.Errorf("Expected error code %s, but got %v", pokererr.CodeInvalidHoleCardCount, err)
+	}
+}
+
+func sameCards(first, second []string) bool {
+	if len(first) != len(second) {
+		return false
+	}
+
+	counts := make(map[string]int)
+	for _, card := range first {
+		counts[card]++
+	}
+	for _, card := range second {
+		counts[card]--
+		if counts[card] < 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,199 @@
+package holdem
+
+import (
+	"sort"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+// Reasons reported in the details of CodeInvalidCard errors.
+const (
+	InvalidCardReasonLength      = "invalid_length"
+	InvalidCardReasonUnknownRank = "unknown_rank"
+	InvalidCardReasonUnknownSuit = "unknown_suit"
+	InvalidCardReasonNotInDeck   = "not_in_deck"
+)
+
+const (
+	boardLocation = "board"
+	deadLocation  = "dead"
+)
+
+var cardSuits = map[CardSuit]bool{"S": true, "D": true, "H": true, "C": true}
+
+// ParseCard parses a card written as an uppercase rank followed by an uppercase suit, e.g. "TS" or "2H".
+// Anything else is rejected with a CodeInvalidCard error that contains the card and the reason.
+func ParseCard(cardString string) (Card, error) {
+	if reason := invalidCardReason(cardString); reason != "" {
+		return Card{}, pokererr.NewError(pokererr.CodeInvalidCard, pokererr.Data{
+			"card":   cardString,
+			"reason": reason,
+		})
+	}
+
+	return newCard(cardString), nil
+}
+
+// ParseCards parses all cards and checks that none of them repeats. All invalid cards are reported at once
+// in the "cards" details of a CodeInvalidCard error, repeated cards are reported by a CodeDuplicateCard error.
+func ParseCards(cardStrings []string) ([]Card, error) {
+	parser := newDealParser()
+	cards := parser.parse("", cardStrings)
+
+	if err := parser.err(); err != nil {
+		return nil, err
+	}
+
+	return cards, nil
+}
+
+// parseDeal parses the board and all hands and makes sure that no card is dealt twice across all of them.
+// Hands are parsed in seat order, so the same invalid input always produces the same error.
+func parseDeal(board []string, seats Seats) ([]Card, []Hand, error) {
+	parser := newDealParser()
+	boardCards := parser.parse(boardLocation, board)
+	handsWithCards := parser.parseSeats(seats)
+
+	if err := parser.err(); err != nil {
+		return nil, nil, err
+	}
+
+	return boardCards, handsWithCards, nil
+}
+
+// dealParser collects invalid and repeated cards of a whole deal, so they can be reported in one error.
+type dealParser struct {
+	invalid   []pokererr.Data
+	seen      map[string][]string
+	seenOrder []string
+	// allowJokers accepts any number of jokers, they are never reported as repeated.
+	allowJokers bool
+	seatsErr    error
+}
+
+func newDealParser() *dealParser {
+	return &dealParser{seen: make(map[string][]string)}
+}
+
+func (p *dealParser) parse(location string, cardStrings []string) []Card {
+	cards := make([]Card, 0, len(cardStrings))
+
+	for _, cardString := range cardStrings {
+		if p.allowJokers && cardString == Joker.String() {
+			cards = append(cards, Joker)
+			continue
+		}
+
+		if reason := invalidCardReason(cardString); reason != "" {
+			details := pokererr.Data{"card": cardString, "reason": reason}
+			if location != "" {
+				details["location"] = location
+			}
+			p.invalid = append(p.invalid, details)
+			continue
+		}
+
+		if _, ok := p.seen[cardString]; !ok {
+			p.seenOrder = append(p.seenOrder, cardString)
+		}
+		p.seen[cardString] = append(p.seen[cardString], location)
+
+		cards = append(cards, newCard(cardString))
+	}
+
+	return cards
+}
+
+func (p *dealParser) parseSeats(seats Seats) []Hand {
+	if err := validateSeats(seats); err != nil && p.seatsErr == nil {
+		p.seatsErr = err
+	}
+
+	handsWithCards := make([]Hand, 0, len(seats))
+	for _, seat := range seats {
+		handsWithCards = append(handsWithCards, Hand{
+			Name:  seat.Name,
+			Cards: p.parse(handLocation(seat.Name), seat.Cards),
+		})
+	}
+
+	return handsWithCards
+}
+
+// used returns every valid card seen by the parser.
+func (p *dealParser) used() map[Card]bool {
+	used := make(map[Card]bool, len(p.seenOrder))
+	for _, cardString := range p.seenOrder {
+		used[newCard(cardString)] = true
+	}
+
+	return used
+}
+
+func (p *dealParser) err() error {
+	if p.seatsErr != nil {
+		return p.seatsErr
+	}
+
+	if len(p.invalid) > 0 {
+		return pokererr.NewError(pokererr.CodeInvalidCard, pokererr.Data{"cards": p.invalid})
+	}
+
+	var duplicates []pokererr.Data
+	for _, cardString := range p.seenOrder {
+		locations := p.seen[cardString]
+		if len(locations) < 2 {
+			continue
+		}
+
+		details := pokererr.Data{"card": cardString, "count": len(locations)}
+		if locations[0] != "" {
+			details["locations"] = locations
+		}
+		duplicates = append(duplicates, details)
+	}
+
+	if len(duplicates) > 0 {
+		return pokererr.NewError(pokererr.CodeDuplicateCard, pokererr.Data{"cards": duplicates})
+	}
+
+	return nil
+}
+
+func invalidCardReason(cardString string) string {
+	if len(cardString) != 2 {
+		return InvalidCardReasonLength
+	}
+	if _, ok := cardWeights[CardName(cardString[0])]; !ok {
+		return InvalidCardReasonUnknownRank
+	}
+	if !cardSuits[CardSuit(cardString[1])] {
+		return InvalidCardReasonUnknownSuit
+	}
+
+	return ""
+}
+
+func newCard(cardString string) Card {
+	name := CardName(cardString[0])
+
+	return Card{
+		Name:   name,
+		Suit:   CardSuit(cardString[1]),
+		Weight: ResolveWeight(name),
+	}
+}
+
+func handLocation(handName string) string {
+	return "hands." + handName
+}
+
+func sortedHandNames(hands Hands) []string {
+	handNames := make([]string, 0, len(hands))
+	for handName := range hands {
+		handNames = append(handNames, handName)
+	}
+	sort.Strings(handNames)
+
+	return handNames
+}
@@ -0,0 +1,114 @@
+package holdem
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+func TestParseCard(t *testing.T) {
+	tests := []struct {
+		name           string
+		card           string
+		expected       Card
+		expectedReason string
+	}{
+		{
+			name:     "Valid card",
+			card:     "TS",
+			expected: Card{Name: "T", Suit: "S", Weight: 10},
+		},
+		{
+			name:           "Empty string",
+			card:           "",
+			expectedReason: InvalidCardReasonLength,
+		},
+		{
+			name:           "Ten written with two digits",
+			card:           "10H",
+			expectedReason: InvalidCardReasonLength,
+		},
+		{
+			name:           "Unknown rank",
+			card:           "1H",
+			expectedReason: InvalidCardReasonUnknownRank,
+		},
+		{
+			name:           "Lowercase rank",
+			card:           "kH",
+			expectedReason: InvalidCardReasonUnknownRank,
+		},
+		{
+			name:           "Unknown suit",
+			card:           "AX",
+			expectedReason: InvalidCardReasonUnknownSuit,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			card, err := ParseCard(test.card)
+			if test.expectedReason == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error %v", err)
+				}
+				if card != test.expected {
+					t.Errorf("Expected card %v, but got %v", test.expected, card)
+				}
+				return
+			}
+
+			var pokerError *pokererr.Error
+			if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeInvalidCard {
+				t.Fatalf("Expected error code %s, but got %v", pokererr.CodeInvalidCard, err)
+			}
+			if pokerError.Data["reason"] != test.expectedReason || pokerError.Data["card"] != test.card {
+				t.Errorf("Expected reason %s for %q, but got %v", test.expectedReason, test.card, pokerError.Data)
+			}
+		})
+	}
+}
+
+func TestParseCards(t *testing.T) {
+	_, err := ParseCards([]string{"AS", "ZS", "KD", "A"})
+
+	var pokerError *pokererr.Error
+	if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeInvalidCard {
+		t.Fatalf("Expected error code %s, but got %v", pokererr.CodeInvalidCard, err)
+	}
+
+	expected := []pokererr.Data{
+		{"card": "ZS", "reason": InvalidCardReasonUnknownRank},
+		{"card": "A", "reason": InvalidCardReasonLength},
+	}
+	if !reflect.DeepEqual(pokerError.Data["cards"], expected) {
+		t.Errorf("Expected details %v, but got %v", expected, pokerError.Data["cards"])
+	}
+
+	_, err = ParseCards([]string{"AS", "KD", "AS"})
+	if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeDuplicateCard {
+		t.Errorf("Expected error code %s, but got %v", pokererr.CodeDuplicateCard, err)
+	}
+}
+
+func TestParseDeal_DuplicateCards(t *testing.T) {
+	_, _, err := parseDeal([]string{"AS", "KD", "7C"}, Hands{
+		"first":  {"AS", "2C"},
+		"second": {"2C", "3D"},
+	}.Seats())
+
+	var pokerError *pokererr.Error
+	if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeDuplicateCard {
+		t.Fatalf("Expected error code %s, but got %v", pokererr.CodeDuplicateCard, err)
+	}
+
+	expected := []pokererr.Data{
+		{"card": "AS", "count": 2, "locations": []string{"board", "hands.first"}},
+		{"card": "2C", "count": 2, "locations": []string{"hands.first", "hands.second"}},
+	}
+	if !reflect.DeepEqual(pokerError.Data["cards"], expected) {
+		t.Errorf("Expected details %v, but got %v", expected, pokerError.Data["cards"])
+	}
+}
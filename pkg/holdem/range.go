@@ -0,0 +1,325 @@
+package holdem
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+// Reasons reported in the details of CodeInvalidRange errors.
+const (
+	InvalidRangeReasonSyntax = "invalid_syntax"
+	InvalidRangeReasonRank   = "unknown_rank"
+	InvalidRangeReasonSpan   = "invalid_span"
+	InvalidRangeReasonWeight = "invalid_weight"
+)
+
+type suitedness int
+
+const (
+	anySuitedness suitedness = iota
+	suited
+	offsuit
+)
+
+// Combo is one pair of hole cards of a range and the weight it's taken with, from 0 (never) to 1 (always).
+type Combo struct {
+	Cards  [2]Card `json:"cards"`
+	Weight float64 `json:"weight"`
+}
+
+// String returns both cards of the combo, the higher one first, e.g. "AHKH".
+func (c Combo) String() string {
+	return c.Cards[0].String() + c.Cards[1].String()
+}
+
+// Range is a list of weighted combos in the order they appear in the notation.
+type Range []Combo
+
+// ParseRange Complexity: O(n) (linear time)
+// Expands the standard range notation into weighted combos. Parts are separated by commas:
+//   - "QQ" is a pair, "AKs" are suited cards, "AKo" are offsuit cards and "AK" are both;
+//   - "QQ+" are QQ and all higher pairs, "ATs+" are ATs, AJs, AQs and AKs;
+//   - "QQ-99" and "A5s-A2s" are spans of pairs or of hands with the same high card;
+//   - "AHKH" (or "AhKh") is one exact combo;
+//   - any part may end with ":0.5" to take its combos with the given weight.
+//
+// A combo mentioned twice keeps the last weight.
+func ParseRange(notation string) (Range, error) {
+	var (
+		result  Range
+		indexes = make(map[string]int)
+	)
+
+	for _, part := range strings.Split(notation, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		combos, err := parseRangePart(part)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, combo := range combos {
+			if i, ok := indexes[combo.String()]; ok {
+				result[i].Weight = combo.Weight
+				continue
+			}
+			indexes[combo.String()] = len(result)
+			result = append(result, combo)
+		}
+	}
+
+	return result, nil
+}
+
+// ParseRanges Complexity: O(n) (linear time)
+// Parses the notation of every player with ParseRange, players are checked in the order of their names and the details of
+// the first CodeInvalidRange error also name the player's range.
+func ParseRanges(notations map[string]string) (map[string]Range, error) {
+	names := make([]string, 0, len(notations))
+	for name := range notations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make(map[string]Range, len(notations))
+	for _, name := range names {
+		parsed, err := ParseRange(notations[name])
+		if err != nil {
+			var pokerError *pokererr.Error
+			if errors.As(err, &pokerError) {
+				pokerError.Data["range"] = name
+			}
+			return nil, err
+		}
+		result[name] = parsed
+	}
+
+	return result, nil
+}
+
+// RemoveBlocked returns combos that don't use any of the given cards, e.g. the board or dead cards.
+func (r Range) RemoveBlocked(cards []Card) Range {
+	blocked := make(map[Card]bool, len(cards))
+	for _, card := range cards {
+		blocked[card] = true
+	}
+
+	result := make(Range, 0, len(r))
+	for _, combo := range r {
+		if !blocked[combo.Cards[0]] && !blocked[combo.Cards[1]] && combo.Weight > 0 {
+			result = append(result, combo)
+		}
+	}
+
+	return result
+}
+
+func parseRangePart(part string) ([]Combo, error) {
+	weight := 1.0
+	if i := strings.IndexByte(part, ':'); i >= 0 {
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(part[i+1:]), 64)
+		if err != nil || parsed <= 0 || parsed > 1 {
+			return nil, invalidRangeError(part, InvalidRangeReasonWeight)
+		}
+		weight = parsed
+		part = strings.TrimSpace(part[:i])
+	}
+
+	var (
+		combos [][2]Card
+		reason string
+	)
+	switch {
+	case isExactCombo(part):
+		combos, reason = parseExactCombo(part)
+	case strings.Contains(part, "-"):
+		combos, reason = parseRangeSpan(part)
+	case strings.HasSuffix(part, "+"):
+		combos, reason = parseRangePlus(strings.TrimSuffix(part, "+"))
+	default:
+		var class handClass
+		if class, reason = parseHandClass(part); reason == "" {
+			combos = class.combos()
+		}
+	}
+	if reason != "" {
+		return nil, invalidRangeError(part, reason)
+	}
+
+	result := make([]Combo, len(combos))
+	for i, cards := range combos {
+		result[i] = Combo{Cards: cards, Weight: weight}
+	}
+
+	return result, nil
+}
+
+// handClass is a group of combos like "AKs" or "QQ", high and low are indexes in cardsList.
+type handClass struct {
+	high, low  int
+	suitedness suitedness
+}
+
+func (c handClass) isPair() bool {
+	return c.high == c.low
+}
+
+func (c handClass) combos() [][2]Card {
+	var combos [][2]Card
+	for i, firstSuit := range cardSuitsList {
+		for j, secondSuit := range cardSuitsList {
+			if c.isPair() && j <= i {
+				continue
+			}
+			if (c.suitedness == suited && firstSuit != secondSuit) ||
+				(c.suitedness == offsuit && firstSuit == secondSuit) {
+				continue
+			}
+
+			combos = append(combos, [2]Card{
+				newCard(string(cardsList[c.high]) + string(firstSuit)),
+				newCard(string(cardsList[c.low]) + string(secondSuit)),
+			})
+		}
+	}
+
+	return combos
+}
+
+func parseHandClass(part string) (handClass, string) {
+	if len(part) < 2 || len(part) > 3 {
+		return handClass{}, InvalidRangeReasonSyntax
+	}
+
+	high, low := rankIndex(part[0]), rankIndex(part[1])
+	if high < 0 || low < 0 {
+		return handClass{}, InvalidRangeReasonRank
+	}
+	if high < low {
+		high, low = low, high
+	}
+
+	class := handClass{high: high, low: low}
+	if len(part) == 3 {
+		switch part[2] {
+		case 's', 'S':
+			class.suitedness = suited
+		case 'o', 'O':
+			class.suitedness = offsuit
+		default:
+			return handClass{}, InvalidRangeReasonSyntax
+		}
+		if class.isPair() {
+			return handClass{}, InvalidRangeReasonSyntax
+		}
+	}
+
+	return class, ""
+}
+
+// parseRangePlus expands "QQ+" up to aces and "ATs+" up to the kicker right below the high card.
+func parseRangePlus(part string) ([][2]Card, string) {
+	class, reason := parseHandClass(part)
+	if reason != "" {
+		return nil, reason
+	}
+
+	last := class.high - 1
+	if class.isPair() {
+		last = len(cardsList) - 1
+	}
+
+	return spanCombos(class, last), ""
+}
+
+// parseRangeSpan expands "QQ-99" and "A5s-A2s", the ends may go in any order.
+func parseRangeSpan(part string) ([][2]Card, string) {
+	ends := strings.Split(part, "-")
+	if len(ends) != 2 {
+		return nil, InvalidRangeReasonSyntax
+	}
+
+	from, reason := parseHandClass(ends[0])
+	if reason != "" {
+		return nil, reason
+	}
+	to, reason := parseHandClass(ends[1])
+	if reason != "" {
+		return nil, reason
+	}
+
+	if from.isPair() != to.isPair() || from.suitedness != to.suitedness || (!from.isPair() && from.high != to.high) {
+		return nil, InvalidRangeReasonSpan
+	}
+
+	if from.low > to.low {
+		from, to = to, from
+	}
+
+	return spanCombos(from, to.low), ""
+}
+
+// spanCombos returns combos of the class with the low rank going up to the last index, pairs go up with both ranks.
+func spanCombos(class handClass, last int) [][2]Card {
+	var combos [][2]Card
+	for low := class.low; low <= last; low++ {
+		current := class
+		current.low = low
+		if class.isPair() {
+			current.high = low
+		}
+		combos = append(combos, current.combos()...)
+	}
+
+	return combos
+}
+
+func isExactCombo(part string) bool {
+	return len(part) == 4 && isSuitLetter(part[1]) && isSuitLetter(part[3])
+}
+
+func parseExactCombo(part string) ([][2]Card, string) {
+	first, err := ParseCard(strings.ToUpper(part[:2]))
+	if err != nil {
+		return nil, InvalidRangeReasonRank
+	}
+	second, err := ParseCard(strings.ToUpper(part[2:]))
+	if err != nil || first == second {
+		return nil, InvalidRangeReasonRank
+	}
+
+	if second.Weight > first.Weight {
+		first, second = second, first
+	}
+
+	return [][2]Card{{first, second}}, ""
+}
+
+func isSuitLetter(letter byte) bool {
+	return cardSuits[CardSuit(strings.ToUpper(string(letter)))]
+}
+
+func rankIndex(letter byte) int {
+	name := CardName(strings.ToUpper(string(letter)))
+	for i, cardName := range cardsList {
+		if cardName == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func invalidRangeError(part, reason string) error {
+	return pokererr.NewError(pokererr.CodeInvalidRange, pokererr.Data{
+		"part":   part,
+		"reason": reason,
+	})
+}
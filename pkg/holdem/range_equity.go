@@ -0,0 +1,493 @@
+package holdem
+
+import (
+	"context"
+	"math/rand"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+const (
+	// Monte Carlo gives up drawing combos that don't conflict with each other after this many attempts in a row.
+	rangeSampleAttempts = 1000
+	// Exhaustive enumeration is rejected when it would evaluate more boards than one hand against another before
+	// the flop, C(48, 5). Monte Carlo handles bigger deals.
+	maxExhaustiveRangeBoards = 1712304
+)
+
+type RangeEquityRequest struct {
+	// Ranges are ranges of every player. A known hand is a range of one combo, e.g. ParseRange("AHKH").
+	Ranges map[string]Range
+	// Board is the known part of the board, from zero to five cards.
+	Board []string
+	// Dead are cards that are known to be out of the deck, e.g. folded cards.
+	Dead []string
+	// Iterations is the number of random deals for Monte Carlo simulation. Zero means exhaustive enumeration
+	// of all combos of every range against each other on all boards, up to maxExhaustiveRangeBoards boards.
+	Iterations int
+	// Seed makes Monte Carlo simulation reproducible. Zero means a random seed, the used one is returned in the result.
+	Seed int64
+	// Workers is the number of goroutines, zero means the number of CPUs.
+	Workers int
+}
+
+type RangeEquityResult struct {
+	Ranges map[string]*RangeEquity `json:"ranges"`
+	// Boards is the number of evaluated boards.
+	Boards     int64 `json:"boards"`
+	Exhaustive bool  `json:"exhaustive"`
+	Seed       int64 `json:"seed,omitempty"`
+}
+
+// RangeEquity is the aggregate equity of a whole range and the equity of every combo of it.
+// Combos blocked by the board or dead cards are not listed.
+type RangeEquity struct {
+	HandEquity
+	Combos []*ComboEquity `json:"combos"`
+}
+
+type ComboEquity struct {
+	HandEquity
+	Weight float64 `json:"weight"`
+}
+
+// CalculateRangeEquity computes win, tie and lose percentages of ranges against each other. Combos are taken
+// proportionally to their weights and combos that share cards never meet. The result is reported for every range
+// in aggregate and for each of its combos. The work is spread across goroutines and stops with the context error
+// as soon as the context is done.
+func CalculateRangeEquity(ctx context.Context, req RangeEquityRequest) (*RangeEquityResult, error) {
+	if len(req.Ranges) < minEquityHandsCount {
+		return nil, pokererr.NewError(pokererr.CodeNotEnoughHands, pokererr.Data{
+			"count": len(req.Ranges),
+			"min":   minEquityHandsCount,
+		})
+	}
+	if len(req.Board) > maxBoardCardsCount {
+		return nil, pokererr.NewError(pokererr.CodeInvalidBoardSize, pokererr.Data{
+			"count": len(req.Board),
+			"min":   0,
+			"max":   maxBoardCardsCount,
+		})
+	}
+	if req.Iterations < 0 {
+		return nil, pokererr.NewError(pokererr.CodeInvalidIterations, pokererr.Data{"iterations": req.Iterations})
+	}
+	if err := validateDealSize(len(req.Ranges), len(req.Dead)); err != nil {
+		return nil, err
+	}
+
+	parser := newDealParser()
+	board := parser.parse(boardLocation, req.Board)
+	parser.parse(deadLocation, req.Dead)
+	if err := parser.err(); err != nil {
+		return nil, err
+	}
+
+	known := make([]Card, 0, len(parser.seenOrder))
+	for card := range parser.used() {
+		known = append(known, card)
+	}
+
+	names := make([]string, 0, len(req.Ranges))
+	for name := range req.Ranges {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ranges := make([]Range, len(names))
+	for i, name := range names {
+		ranges[i] = req.Ranges[name].RemoveBlocked(known)
+		if len(ranges[i]) == 0 {
+			return nil, pokererr.NewError(pokererr.CodeEmptyRange, pokererr.Data{"range": name})
+		}
+	}
+
+	deal := newRangeDeal(board, ranges, remainingDeck(parser.used()))
+	if boards := deal.exhaustiveBoards(); req.Iterations == 0 && boards > maxExhaustiveRangeBoards {
+		return nil, pokererr.NewError(pokererr.CodeInvalidIterations, pokererr.Data{
+			"iterations": req.Iterations,
+			"boards":     boards,
+			"max":        maxExhaustiveRangeBoards,
+		})
+	}
+
+	workers := req.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	result := &RangeEquityResult{Ranges: make(map[string]*RangeEquity, len(names))}
+
+	var (
+		stats *rangeStats
+		err   error
+	)
+	if req.Iterations == 0 {
+		result.Exhaustive = true
+		stats, err = deal.enumerate(ctx, workers)
+	} else {
+		result.Seed = req.Seed
+		if result.Seed == 0 {
+			result.Seed = time.Now().UnixNano()
+		}
+		stats, err = deal.simulate(ctx, workers, req.Iterations, result.Seed)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if stats.boards == 0 {
+		return nil, pokererr.NewError(pokererr.CodeEmptyRange, pokererr.Data{"ranges": names})
+	}
+
+	result.Boards = stats.boards
+	for i, name := range names {
+		rangeEquity := &RangeEquity{
+			HandEquity: *stats.ranges[i].toHandEquity(name),
+			Combos:     make([]*ComboEquity, len(ranges[i])),
+		}
+		for j, combo := range ranges[i] {
+			rangeEquity.Combos[j] = &ComboEquity{
+				HandEquity: *stats.combos[i][j].toHandEquity(combo.String()),
+				Weight:     combo.Weight,
+			}
+		}
+		result.Ranges[name] = rangeEquity
+	}
+
+	return result, nil
+}
+
+// rangeStats are counters of every range and every combo of it, indexed the same way as rangeDeal.ranges.
+type rangeStats struct {
+	ranges []equityStats
+	combos [][]equityStats
+	boards int64
+}
+
+func newRangeStats(ranges [][]packedCombo) *rangeStats {
+	stats := &rangeStats{
+		ranges: make([]equityStats, len(ranges)),
+		combos: make([][]equityStats, len(ranges)),
+	}
+	for i, combos := range ranges {
+		stats.combos[i] = make([]equityStats, len(combos))
+	}
+
+	return stats
+}
+
+func (s *rangeStats) merge(other *rangeStats) {
+	s.boards += other.boards
+	for i := range s.ranges {
+		s.ranges[i].merge(other.ranges[i])
+		for j := range s.combos[i] {
+			s.combos[i][j].merge(other.combos[i][j])
+		}
+	}
+}
+
+type packedCombo struct {
+	cards  [2]PackedCard
+	weight float64
+}
+
+// rangeDeal is a deal where every player holds one of the combos of a range.
+type rangeDeal struct {
+	board  []PackedCard
+	ranges [][]packedCombo
+	// cumulative are running sums of combo weights of every range, used to draw a combo by its weight.
+	cumulative [][]float64
+	deck       []PackedCard
+	missing    int
+}
+
+func newRangeDeal(board []Card, ranges []Range, deck []Card) *rangeDeal {
+	deal := &rangeDeal{
+		board:      PackCards(board),
+		ranges:     make([][]packedCombo, len(ranges)),
+		cumulative: make([][]float64, len(ranges)),
+		deck:       PackCards(deck),
+		missing:    maxBoardCardsCount - len(board),
+	}
+
+	for i, combos := range ranges {
+		var sum float64
+		deal.ranges[i] = make([]packedCombo, len(combos))
+		deal.cumulative[i] = make([]float64, len(combos))
+		for j, combo := range combos {
+			sum += combo.Weight
+			deal.ranges[i][j] = packedCombo{
+				cards:  [2]PackedCard{PackCard(combo.Cards[0]), PackCard(combo.Cards[1])},
+				weight: combo.Weight,
+			}
+			deal.cumulative[i][j] = sum
+		}
+	}
+
+	return deal
+}
+
+// exhaustiveBoards estimates the number of boards of exhaustive enumeration from above: every choice of combos,
+// including the ones that share cards, times the completions of the board.
+func (d *rangeDeal) exhaustiveBoards() float64 {
+	boards := binomial(len(d.deck)-len(d.ranges)*holdemHoleCardsCount, d.missing)
+	for _, combos := range d.ranges {
+		boards *= float64(len(combos))
+	}
+
+	return boards
+}
+
+// enumerate Complexity: O(m * C(n, k)) (binomial)
+// Every combination of combos that don't share cards is a job. Workers evaluate all completions of the board
+// for each job, every board is weighted by the product of combo weights.
+func (d *rangeDeal) enumerate(ctx context.Context, workers int) (*rangeStats, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan []int)
+	go func() {
+		defer close(jobs)
+
+		var (
+			choice  = make([]int, len(d.ranges))
+			used    = make(map[PackedCard]bool)
+			stopped bool
+		)
+		var walk func(player int)
+		walk = func(player int) {
+			if stopped {
+				return
+			}
+			if player == len(d.ranges) {
+				select {
+				case <-ctx.Done():
+					stopped = true
+				case jobs <- append([]int{}, choice...):
+				}
+				return
+			}
+
+			for j, combo := range d.ranges[player] {
+				if used[combo.cards[0]] || used[combo.cards[1]] {
+					continue
+				}
+				used[combo.cards[0]], used[combo.cards[1]] = true, true
+				choice[player] = j
+				walk(player + 1)
+				used[combo.cards[0]], used[combo.cards[1]] = false, false
+			}
+		}
+		walk(0)
+	}()
+
+	return d.run(ctx, cancel, workers, func(stats *rangeStats) error {
+		var (
+			holes = make([][2]PackedCard, len(d.ranges))
+			ranks = make([]HandRank, len(d.ranges))
+			deck  = make([]PackedCard, 0, len(d.deck))
+			board = append(append(make([]PackedCard, 0, maxBoardCardsCount), d.board...), make([]PackedCard, d.missing)...)
+			known = len(d.board)
+		)
+
+		for choice := range jobs {
+			weight := 1.0
+			for i, j := range choice {
+				holes[i] = d.ranges[i][j].cards
+				weight *= d.ranges[i][j].weight
+			}
+
+			deck = deck[:0]
+			for _, card := range d.deck {
+				if !holesContain(holes, card) {
+					deck = append(deck, card)
+				}
+			}
+
+			var cancelled bool
+			forEachCombination(len(deck), d.missing, func(indexes []int) {
+				if cancelled {
+					return
+				}
+				for i, index := range indexes {
+					board[known+i] = deck[index]
+				}
+				d.score(stats, choice, holes, board, ranks, weight)
+
+				if stats.boards%equityCancelCheckInterval == 0 && ctx.Err() != nil {
+					cancelled = true
+				}
+			})
+			if cancelled {
+				return ctx.Err()
+			}
+		}
+
+		return ctx.Err()
+	})
+}
+
+// simulate Complexity: O(n) (linear time)
+// Every iteration draws a combo for each range by its weight, starting over when combos share cards,
+// and then draws the rest of the board. Batches are seeded the same way as in equityDeal.simulate.
+func (d *rangeDeal) simulate(ctx context.Context, workers, iterations int, seed int64) (*rangeStats, error) {
+	// Workers may give up on conflicting ranges, run cancels the context then to stop the producer and other workers.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan int)
+	go func() {
+		defer close(jobs)
+		for batch := 0; batch*equityBatchSize < iterations; batch++ {
+			select {
+			case <-ctx.Done():
+				return
+			case jobs <- batch:
+			}
+		}
+	}()
+
+	return d.run(ctx, cancel, workers, func(stats *rangeStats) error {
+		var (
+			choice = make([]int, len(d.ranges))
+			holes  = make([][2]PackedCard, len(d.ranges))
+			ranks  = make([]HandRank, len(d.ranges))
+			deck   = make([]PackedCard, len(d.deck))
+			board  = append(append(make([]PackedCard, 0, maxBoardCardsCount), d.board...), make([]PackedCard, d.missing)...)
+			known  = len(d.board)
+		)
+
+		for batch := range jobs {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			random := rand.New(rand.NewSource(seed + int64(batch)))
+
+			size := iterations - batch*equityBatchSize
+			if size > equityBatchSize {
+				size = equityBatchSize
+			}
+
+			for i := 0; i < size; i++ {
+				if !d.drawCombos(random, choice, holes) {
+					return pokererr.NewError(pokererr.CodeEmptyRange, pokererr.Data{"reason": "conflicting_ranges"})
+				}
+
+				copy(deck, d.deck)
+				drawn := 0
+				for j := 0; drawn < d.missing; j++ {
+					k := j + random.Intn(len(deck)-j)
+					deck[j], deck[k] = deck[k], deck[j]
+					if holesContain(holes, deck[j]) {
+						continue
+					}
+					board[known+drawn] = deck[j]
+					drawn++
+				}
+
+				d.score(stats, choice, holes, board, ranks, 1)
+			}
+		}
+
+		return ctx.Err()
+	})
+}
+
+// drawCombos draws a combo of every range by its weight until the combos don't share any card.
+func (d *rangeDeal) drawCombos(random *rand.Rand, choice []int, holes [][2]PackedCard) bool {
+	for attempt := 0; attempt < rangeSampleAttempts; attempt++ {
+		conflict := false
+		for i, cumulative := range d.cumulative {
+			j := sort.SearchFloat64s(cumulative, random.Float64()*cumulative[len(cumulative)-1])
+			if j == len(cumulative) {
+				j--
+			}
+			choice[i], holes[i] = j, d.ranges[i][j].cards
+
+			if holesContain(holes[:i], holes[i][0]) || holesContain(holes[:i], holes[i][1]) {
+				conflict = true
+				break
+			}
+		}
+		if !conflict {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *rangeDeal) score(
+	stats *rangeStats,
+	choice []int,
+	holes [][2]PackedCard,
+	board []PackedCard,
+	ranks []HandRank,
+	weight float64,
+) {
+	best, winners := rankBoard(holes, board, ranks)
+	for i, j := range choice {
+		stats.ranges[i].record(ranks[i], best, winners, weight)
+		stats.combos[i][j].record(ranks[i], best, winners, weight)
+	}
+	stats.boards++
+}
+
+// run starts workers with their own counters and merges the counters when all workers are done. The first error
+// of a worker cancels the context, so the rest of the workers stop early and the error is returned.
+func (d *rangeDeal) run(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	workers int,
+	work func(stats *rangeStats) error,
+) (*rangeStats, error) {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		total    = newRangeStats(d.ranges)
+		firstErr error
+	)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			stats := newRangeStats(d.ranges)
+			err := work(stats)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil && firstErr == nil {
+				firstErr = err
+				cancel()
+			}
+			total.merge(stats)
+		}()
+	}
+	wg.Wait()
+
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return total, nil
+}
+
+func holesContain(holes [][2]PackedCard, card PackedCard) bool {
+	for _, hole := range holes {
+		if hole[0] == card || hole[1] == card {
+			return true
+		}
+	}
+
+	return false
+}
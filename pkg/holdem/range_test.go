@@ -0,0 +1,326 @@
+package holdem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		name          string
+		notation      string
+		expectedCount int
+		expectedFirst string
+		expectedLast  string
+	}{
+		{name: "Pair", notation: "QQ", expectedCount: 6, expectedFirst: "QSQH", expectedLast: "QDQC"},
+		{name: "Pair and higher", notation: "QQ+", expectedCount: 18, expectedFirst: "QSQH", expectedLast: "ADAC"},
+		{name: "Span of pairs", notation: "QQ-99", expectedCount: 24, expectedFirst: "9S9H", expectedLast: "QDQC"},
+		{name: "Suited", notation: "AKs", expectedCount: 4, expectedFirst: "ASKS", expectedLast: "ACKC"},
+		{name: "Offsuit", notation: "KQo", expectedCount: 12, expectedFirst: "KSQH", expectedLast: "KCQD"},
+		{name: "Suited and offsuit", notation: "AK", expectedCount: 16, expectedFirst: "ASKS", expectedLast: "ACKC"},
+		{name: "Kicker and higher", notation: "ATs+", expectedCount: 16, expectedFirst: "ASTS", expectedLast: "ACKC"},
+		{name: "Span of kickers", notation: "A5s-A2s", expectedCount: 16, expectedFirst: "AS2S", expectedLast: "AC5C"},
+		{name: "Exact combo", notation: "KhAh", expectedCount: 1, expectedFirst: "AHKH", expectedLast: "AHKH"},
+		{name: "Several parts", notation: "QQ+, AKs, A5s-A2s, KQo", expectedCount: 50, expectedFirst: "QSQH", expectedLast: "KCQD"},
+		{name: "Overlapping parts", notation: "AA, AA, AKs, AsKs", expectedCount: 10, expectedFirst: "ASAH", expectedLast: "ACKC"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := ParseRange(test.notation)
+			if err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+
+			if len(result) != test.expectedCount {
+				t.Fatalf("Expected %d combos, but got %d", test.expectedCount, len(result))
+			}
+			if first := result[0].String(); first != test.expectedFirst {
+				t.Errorf("Expected first combo %s, but got %s", test.expectedFirst, first)
+			}
+			if last := result[len(result)-1].String(); last != test.expectedLast {
+				t.Errorf("Expected last combo %s, but got %s", test.expectedLast, last)
+			}
+		})
+	}
+}
+
+func TestParseRange_Weights(t *testing.T) {
+	result, err := ParseRange("AA:0.5, AsAh")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	weights := make(map[string]float64)
+	for _, combo := range result {
+		weights[combo.String()] = combo.Weight
+	}
+
+	if weights["ASAH"] != 1 || weights["ADAC"] != 0.5 {
+		t.Errorf("Expected the exact combo to override the weight of the pair, but got %v", weights)
+	}
+}
+
+func TestParseRange_Invalid(t *testing.T) {
+	tests := []struct {
+		name           string
+		notation       string
+		expectedReason string
+	}{
+		{name: "Unknown rank", notation: "AXs", expectedReason: InvalidRangeReasonRank},
+		{name: "Unknown suitedness", notation: "AKx", expectedReason: InvalidRangeReasonSyntax},
+		{name: "Suited pair", notation: "AAs", expectedReason: InvalidRangeReasonSyntax},
+		{name: "Span of different high cards", notation: "A5s-K2s", expectedReason: InvalidRangeReasonSpan},
+		{name: "Span of pair and non-pair", notation: "QQ-AKs", expectedReason: InvalidRangeReasonSpan},
+		{name: "Weight above one", notation: "AA:2", expectedReason: InvalidRangeReasonWeight},
+		{name: "Same card twice", notation: "AsAs", expectedReason: InvalidRangeReasonRank},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseRange(test.notation)
+
+			var pokerError *pokererr.Error
+			if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeInvalidRange {
+				t.Fatalf("Expected error code %s, but got %v", pokererr.CodeInvalidRange, err)
+			}
+			if pokerError.Data["reason"] != test.expectedReason {
+				t.Errorf("Expected reason %s, but got %v", test.expectedReason, pokerError.Data["reason"])
+			}
+		})
+	}
+}
+
+func TestParseRanges(t *testing.T) {
+	result, err := ParseRanges(map[string]string{"hero": "AA", "villain": "KQs"})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if len(result["hero"]) != 6 || len(result["villain"]) != 4 {
+		t.Errorf("Expected 6 and 4 combos, but got %d and %d", len(result["hero"]), len(result["villain"]))
+	}
+
+	_, err = ParseRanges(map[string]string{"hero": "AA", "villain": "AXs"})
+
+	var pokerError *pokererr.Error
+	if !errors.As(err, &pokerError) || pokerError.Data["range"] != "villain" {
+		t.Errorf("Expected the error to name the villain range, but got %v", err)
+	}
+}
+
+func TestRange_RemoveBlocked(t *testing.T) {
+	aces, _ := ParseRange("AA")
+	blocker, _ := ParseCard("AS")
+
+	if remaining := aces.RemoveBlocked([]Card{blocker}); len(remaining) != 3 {
+		t.Errorf("Expected 3 combos without the ace of spades, but got %d", len(remaining))
+	}
+}
+
+func TestCalculateRangeEquity_MatchesHandEquity(t *testing.T) {
+	first, _ := ParseRange("AhAd")
+	second, _ := ParseRange("QhJh")
+
+	result, err := CalculateRangeEquity(context.Background(), RangeEquityRequest{
+		Ranges: map[string]Range{"first": first, "second": second},
+		Board:  []string{"AS", "KD", "7C", "2H"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if result.Boards != 44 {
+		t.Fatalf("Expected 44 boards, but got %d", result.Boards)
+	}
+	if win := result.Ranges["second"].Win; math.Abs(win-100*4.0/44) > 1e-9 {
+		t.Errorf("Expected the second range to win %v%%, but got %v", 100*4.0/44, win)
+	}
+}
+
+func TestCalculateRangeEquity_HandVsRange(t *testing.T) {
+	hand, _ := ParseRange("KsKh")
+	opponent, _ := ParseRange("AA, QQ")
+
+	result, err := CalculateRangeEquity(context.Background(), RangeEquityRequest{
+		Ranges: map[string]Range{"hand": hand, "range": opponent},
+		Board:  []string{"2C", "7D", "9H", "3S", "4S"},
+		Dead:   []string{"AH"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	// Three combos of aces left after the dead ace, six combos of queens. Kings beat only queens.
+	rangeEquity := result.Ranges["range"]
+	if len(rangeEquity.Combos) != 9 {
+		t.Fatalf("Expected 9 combos, but got %d", len(rangeEquity.Combos))
+	}
+	if equity := result.Ranges["hand"].Equity; math.Abs(equity-100*6.0/9) > 1e-9 {
+		t.Errorf("Expected equity of kings %v%%, but got %v", 100*6.0/9, equity)
+	}
+
+	wins := make(map[string]float64)
+	for _, combo := range rangeEquity.Combos {
+		wins[combo.HandName] = combo.Win
+	}
+	if wins["ASAD"] != 100 || wins["QSQH"] != 0 {
+		t.Errorf("Expected aces to always win and queens to always lose, but got %v", wins)
+	}
+}
+
+func TestCalculateRangeEquity_MonteCarlo(t *testing.T) {
+	first, _ := ParseRange("QQ+, AKs")
+	second, _ := ParseRange("22+, AT+, KQ")
+
+	request := RangeEquityRequest{
+		Ranges:     map[string]Range{"first": first, "second": second},
+		Iterations: 20000,
+		Seed:       7,
+		Workers:    1,
+	}
+	single, err := CalculateRangeEquity(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	request.Workers = 4
+	parallel, err := CalculateRangeEquity(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(single.Ranges["first"].HandEquity, parallel.Ranges["first"].HandEquity) {
+		t.Errorf("Expected the same result for the same seed, but got %v and %v", single.Ranges["first"], parallel.Ranges["first"])
+	}
+	if equity := single.Ranges["first"].Equity + single.Ranges["second"].Equity; math.Abs(equity-100) > 1e-9 {
+		t.Errorf("Expected equities to sum to 100, but got %v", equity)
+	}
+}
+
+func TestCalculateRangeEquity_EmptyRange(t *testing.T) {
+	aces, _ := ParseRange("AA")
+	kings, _ := ParseRange("KK")
+
+	_, err := CalculateRangeEquity(context.Background(), RangeEquityRequest{
+		Ranges: map[string]Range{"aces": aces, "kings": kings},
+		Board:  []string{"AS", "AH", "AD"},
+	})
+
+	var pokerError *pokererr.Error
+	if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeEmptyRange {
+		t.Errorf("Expected error code %s, but got %v", pokererr.CodeEmptyRange, err)
+	}
+}
+
+func TestCalculateRangeEquity_ConflictingRanges(t *testing.T) {
+	first, _ := ParseRange("AsAh")
+	second, _ := ParseRange("AsAh, KK:0.001")
+
+	// Combos rarely avoid each other, so workers give up one by one and the first one stops the rest.
+	_, err := CalculateRangeEquity(context.Background(), RangeEquityRequest{
+		Ranges:     map[string]Range{"first": first, "second": second},
+		Iterations: 10000000,
+		Workers:    4,
+	})
+
+	var pokerError *pokererr.Error
+	if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeEmptyRange {
+		t.Fatalf("Expected error code %s, but got %v", pokererr.CodeEmptyRange, err)
+	}
+	if pokerError.Data["reason"] != "conflicting_ranges" {
+		t.Errorf("Expected conflicting ranges, but got %v", pokerError.Data)
+	}
+}
+
+func TestCalculateRangeEquity_TooManyHands(t *testing.T) {
+	var cards []string
+	for _, rank := range "23456789TJQKA" {
+		for _, suit := range "CDHS" {
+			cards = append(cards, string(rank)+string(suit))
+		}
+	}
+
+	ranges := make(map[string]Range, 24)
+	for i := 0; i < 24; i++ {
+		hand, err := ParseRange(cards[2*i] + cards[2*i+1])
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		ranges[fmt.Sprintf("range%d", i)] = hand
+	}
+
+	for _, iterations := range []int{0, 1000} {
+		_, err := CalculateRangeEquity(context.Background(), RangeEquityRequest{Ranges: ranges, Iterations: iterations})
+
+		var pokerError *pokererr.Error
+		if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeNotEnoughCards {
+			t.Errorf("Expected error code %s with %d iterations, but got %v", pokererr.CodeNotEnoughCards, iterations, err)
+		}
+	}
+
+	delete(ranges, "range23")
+	result, err := CalculateRangeEquity(context.Background(), RangeEquityRequest{Ranges: ranges, Iterations: 1000})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if result.Boards != 1000 {
+		t.Errorf("Expected 1000 boards of the full deal, but got %d", result.Boards)
+	}
+}
+
+func TestCalculateRangeEquity_ExhaustiveLimit(t *testing.T) {
+	aces, _ := ParseRange("AA")
+	kings, _ := ParseRange("KK")
+
+	// One hand against another before the flop is the most exhaustive enumeration may take.
+	deal := newRangeDeal(nil, []Range{aces[:1], kings[:1]}, remainingDeck(nil))
+	if boards := deal.exhaustiveBoards(); boards != maxExhaustiveRangeBoards {
+		t.Errorf("Expected %d boards of a hand against a hand, but got %v", maxExhaustiveRangeBoards, boards)
+	}
+
+	_, err := CalculateRangeEquity(context.Background(), RangeEquityRequest{
+		Ranges: map[string]Range{"aces": aces, "kings": kings},
+	})
+
+	var pokerError *pokererr.Error
+	if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeInvalidIterations {
+		t.Fatalf("Expected error code %s for pairs against pairs, but got %v", pokererr.CodeInvalidIterations, err)
+	}
+	if boards := pokerError.Data["boards"].(float64); boards != 36*maxExhaustiveRangeBoards {
+		t.Errorf("Expected %d boards, but got %v", 36*maxExhaustiveRangeBoards, boards)
+	}
+}
+
+func TestCalculateRangeEquity_ExhaustiveLimitWideRanges(t *testing.T) {
+	first, _ := ParseRange("QQ+, AKs")
+	second, _ := ParseRange("22+")
+
+	request := RangeEquityRequest{Ranges: map[string]Range{"first": first, "second": second}}
+	_, err := CalculateRangeEquity(context.Background(), request)
+
+	var pokerError *pokererr.Error
+	if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeInvalidIterations {
+		t.Fatalf("Expected error code %s, but got %v", pokererr.CodeInvalidIterations, err)
+	}
+	if boards := pokerError.Data["boards"].(float64); boards <= maxExhaustiveRangeBoards {
+		t.Errorf("Expected more than %d boards, but got %v", maxExhaustiveRangeBoards, boards)
+	}
+
+	// The same ranges are fine for Monte Carlo, and for exhaustive enumeration once most of the board is known.
+	request.Iterations = 1000
+	if _, err := CalculateRangeEquity(context.Background(), request); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+	request.Iterations = 0
+	request.Board = []string{"2C", "7D", "9H", "3S"}
+	if _, err := CalculateRangeEquity(context.Background(), request); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
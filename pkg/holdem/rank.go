@@ -0,0 +1,116 @@
+package holdem
+
+import (
+	"sort"
+)
+
+const (
+	// Each tiebreak card weight fits into four bits, the combination weight goes above five of them.
+	rankTieBreakBits   = 4
+	rankTieBreakCount  = 5
+	rankCombinationPos = rankTieBreakBits * rankTieBreakCount
+)
+
+// HandRank is an exactly comparable value of a hand: the combination weight is packed into the highest bits
+// and is followed by up to five tiebreak card weights in order of significance. A greater rank always wins,
+// equal ranks are a split.
+type HandRank uint32
+
+func newHandRank(combinationWeight int32, tieBreak []CardWeight) HandRank {
+	rank := HandRank(combinationWeight) << rankCombinationPos
+	for i := 0; i < len(tieBreak) && i < rankTieBreakCount; i++ {
+		rank |= HandRank(tieBreak[i]) << (rankTieBreakBits * (rankTieBreakCount - 1 - i))
+	}
+
+	return rank
+}
+
+// CombinationWeight returns the combination weight the rank was built from.
+func (r HandRank) CombinationWeight() int32 {
+	return int32(r >> rankCombinationPos)
+}
+
+// TieBreak returns card weights that decide between hands of the same combination, most significant first.
+func (r HandRank) TieBreak() []CardWeight {
+	tieBreak := make([]CardWeight, 0, rankTieBreakCount)
+	for i := rankTieBreakCount - 1; i >= 0; i-- {
+		weight := CardWeight(r>>(rankTieBreakBits*i)) & (1<<rankTieBreakBits - 1)
+		if weight == 0 {
+			break
+		}
+		tieBreak = append(tieBreak, weight)
+	}
+
+	return tieBreak
+}
+
+// Compare returns a positive number when the hand beats other, a negative one when it loses and zero on a split.
+func (r *HandResult) Compare(other *HandResult) int {
+	switch {
+	case r.Rank > other.Rank:
+		return 1
+	case r.Rank < other.Rank:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// calculateRank Complexity: O(n log n) (linearithmic time)
+// Card weights are grouped by the number of occurrences and sorted so that bigger groups go first and groups
+// of the same size go from the highest weight to the lowest, which is the standard order of tiebreak ranks.
+// Straights are ranked by their top card only, the wheel (A-2-3-4-5) is ranked as five-high.
+func (h *Hand) calculateRank(combinationWeight int32) HandRank {
+	switch combinationWeight {
+	case royalFlushCombinationWeight, straightFlushCombinationWeight, straightCombinationWeight:
+		return newHandRank(combinationWeight, []CardWeight{h.straightHighCard()})
+	}
+
+	weights := make([]CardWeight, len(h.Cards))
+	for i, card := range h.Cards {
+		weights[i] = card.ResolveWeight()
+	}
+
+	return newHandRank(combinationWeight, groupTieBreak(weights))
+}
+
+// groupTieBreak orders distinct weights so that bigger groups go first and groups of the same size go
+// from the highest weight to the lowest.
+func groupTieBreak(weights []CardWeight) []CardWeight {
+	counts := make(map[CardWeight]int)
+	for _, weight := range weights {
+		counts[weight]++
+	}
+
+	tieBreak := make([]CardWeight, 0, len(counts))
+	for weight := range counts {
+		tieBreak = append(tieBreak, weight)
+	}
+	sort.Slice(tieBreak, func(i, j int) bool {
+		if counts[tieBreak[i]] != counts[tieBreak[j]] {
+			return counts[tieBreak[i]] > counts[tieBreak[j]]
+		}
+		return tieBreak[i] > tieBreak[j]
+	})
+
+	return tieBreak
+}
+
+// straightHighCard Complexity: O(n) (linear time)
+// Returns the top card weight of the highest straight in the hand, or zero when there is no straight.
+func (h *Hand) straightHighCard() CardWeight {
+	var present [15]bool
+	for _, card := range h.Cards {
+		present[card.ResolveWeight()] = true
+	}
+	// Ace also plays as the lowest card of the wheel.
+	present[1] = present[14]
+
+	for high := 14; high >= 5; high-- {
+		if present[high] && present[high-1] && present[high-2] && present[high-3] && present[high-4] {
+			return CardWeight(high)
+		}
+	}
+
+	return 0
+}
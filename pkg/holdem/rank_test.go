@@ -0,0 +1,131 @@
+package holdem
+
+import (
+	"testing"
+)
+
+func TestHand_DefineCombination_Rank(t *testing.T) {
+	tests := []struct {
+		name     string
+		winner   []Card
+		loser    []Card
+		expected int
+	}{
+		{
+			name: "Pair of aces with low kickers beats pair of kings with high kickers",
+			winner: []Card{
+				{Suit: "H", Name: "A"}, {Suit: "C", Name: "A"}, {Suit: "D", Name: "2"}, {Suit: "S", Name: "3"}, {Suit: "H", Name: "4"},
+			},
+			loser: []Card{
+				{Suit: "H", Name: "K"}, {Suit: "C", Name: "K"}, {Suit: "D", Name: "A"}, {Suit: "S", Name: "Q"}, {Suit: "H", Name: "J"},
+			},
+			expected: 1,
+		},
+		{
+			name: "Same pair is decided by the kicker",
+			winner: []Card{
+				{Suit: "H", Name: "9"}, {Suit: "C", Name: "9"}, {Suit: "D", Name: "A"}, {Suit: "S", Name: "7"}, {Suit: "H", Name: "3"},
+			},
+			loser: []Card{
+				{Suit: "S", Name: "9"}, {Suit: "D", Name: "9"}, {Suit: "C", Name: "A"}, {Suit: "H", Name: "7"}, {Suit: "C", Name: "2"},
+			},
+			expected: 1,
+		},
+		{
+			name: "Two pair is decided by the higher pair first",
+			winner: []Card{
+				{Suit: "H", Name: "Q"}, {Suit: "C", Name: "Q"}, {Suit: "D", Name: "2"}, {Suit: "S", Name: "2"}, {Suit: "H", Name: "3"},
+			},
+			loser: []Card{
+				{Suit: "S", Name: "J"}, {Suit: "D", Name: "J"}, {Suit: "C", Name: "T"}, {Suit: "H", Name: "T"}, {Suit: "C", Name: "A"},
+			},
+			expected: 1,
+		},
+		{
+			name: "Full house is decided by the three of a kind",
+			winner: []Card{
+				{Suit: "H", Name: "3"}, {Suit: "C", Name: "3"}, {Suit: "D", Name: "3"}, {Suit: "S", Name: "2"}, {Suit: "H", Name: "2"},
+			},
+			loser: []Card{
+				{Suit: "S", Name: "2"}, {Suit: "D", Name: "2"}, {Suit: "C", Name: "2"}, {Suit: "H", Name: "A"}, {Suit: "C", Name: "A"},
+			},
+			expected: 1,
+		},
+		{
+			name: "Six-high straight beats the wheel",
+			winner: []Card{
+				{Suit: "H", Name: "2"}, {Suit: "C", Name: "3"}, {Suit: "D", Name: "4"}, {Suit: "S", Name: "5"}, {Suit: "H", Name: "6"},
+			},
+			loser: []Card{
+				{Suit: "S", Name: "A"}, {Suit: "D", Name: "2"}, {Suit: "C", Name: "3"}, {Suit: "H", Name: "4"}, {Suit: "C", Name: "5"},
+			},
+			expected: 1,
+		},
+		{
+			name: "Steel wheel is a straight flush",
+			winner: []Card{
+				{Suit: "H", Name: "A"}, {Suit: "H", Name: "2"}, {Suit: "H", Name: "3"}, {Suit: "H", Name: "4"}, {Suit: "H", Name: "5"},
+			},
+			loser: []Card{
+				{Suit: "S", Name: "A"}, {Suit: "S", Name: "K"}, {Suit: "S", Name: "Q"}, {Suit: "S", Name: "J"}, {Suit: "S", Name: "9"},
+			},
+			expected: 1,
+		},
+		{
+			name: "Unordered royal flush beats king-high straight flush",
+			winner: []Card{
+				{Suit: "D", Name: "A"}, {Suit: "D", Name: "Q"}, {Suit: "D", Name: "T"}, {Suit: "D", Name: "K"}, {Suit: "D", Name: "J"},
+			},
+			loser: []Card{
+				{Suit: "C", Name: "9"}, {Suit: "C", Name: "T"}, {Suit: "C", Name: "J"}, {Suit: "C", Name: "Q"}, {Suit: "C", Name: "K"},
+			},
+			expected: 1,
+		},
+		{
+			name: "Flushes of different suits with the same weights split",
+			winner: []Card{
+				{Suit: "H", Name: "K"}, {Suit: "H", Name: "9"}, {Suit: "H", Name: "7"}, {Suit: "H", Name: "4"}, {Suit: "H", Name: "2"},
+			},
+			loser: []Card{
+				{Suit: "S", Name: "K"}, {Suit: "S", Name: "9"}, {Suit: "S", Name: "7"}, {Suit: "S", Name: "4"}, {Suit: "S", Name: "2"},
+			},
+			expected: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			winner := (&Hand{Name: "winner", Cards: test.winner}).DefineCombination()
+			loser := (&Hand{Name: "loser", Cards: test.loser}).DefineCombination()
+
+			if got := winner.Compare(loser); got != test.expected {
+				t.Errorf("Expected compare result %d, but got %d (%v vs %v)", test.expected, got, winner, loser)
+			}
+			if got := loser.Compare(winner); got != -test.expected {
+				t.Errorf("Expected reverse compare result %d, but got %d", -test.expected, got)
+			}
+		})
+	}
+}
+
+func TestHandRank_TieBreak(t *testing.T) {
+	hand := &Hand{Cards: []Card{
+		{Suit: "H", Name: "7"}, {Suit: "C", Name: "K"}, {Suit: "D", Name: "7"}, {Suit: "S", Name: "K"}, {Suit: "H", Name: "A"},
+	}}
+	result := hand.DefineCombination()
+
+	if result.Rank.CombinationWeight() != twoPairCombinationWeight {
+		t.Errorf("Expected combination weight %d, but got %d", twoPairCombinationWeight, result.Rank.CombinationWeight())
+	}
+
+	expected := []CardWeight{13, 7, 14}
+	tieBreak := result.Rank.TieBreak()
+	if len(tieBreak) != len(expected) {
+		t.Fatalf("Expected tiebreak %v, but got %v", expected, tieBreak)
+	}
+	for i := range expected {
+		if tieBreak[i] != expected[i] {
+			t.Errorf("Expected tiebreak %v, but got %v", expected, tieBreak)
+		}
+	}
+}
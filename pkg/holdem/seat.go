@@ -0,0 +1,42 @@
+package holdem
+
+import (
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+// Seat is the hand of one player at the table.
+type Seat struct {
+	Name  string   `json:"name"`
+	Cards []string `json:"cards"`
+}
+
+// Seats are hands in the order players sit at the table. Unlike Hands they keep the order of players, so results list
+// hands in seat order and hands with equal rank share a place in seat order.
+type Seats []Seat
+
+// Seats returns the hands as seats ordered by name, it's the order used by every function that takes Hands.
+func (h Hands) Seats() Seats {
+	seats := make(Seats, 0, len(h))
+	for _, handName := range sortedHandNames(h) {
+		seats = append(seats, Seat{Name: handName, Cards: h[handName]})
+	}
+
+	return seats
+}
+
+// validateSeats rejects a seat with the name of one of the previous seats with a CodeInvalidSeat error,
+// since results refer to hands by name.
+func validateSeats(seats Seats) error {
+	names := make(map[string]bool, len(seats))
+	for i, seat := range seats {
+		if names[seat.Name] {
+			return pokererr.NewError(pokererr.CodeInvalidSeat, pokererr.Data{
+				"seat": i,
+				"name": seat.Name,
+			})
+		}
+		names[seat.Name] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package holdem
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+func TestHands_Seats(t *testing.T) {
+	seats := Hands{"west": {"AS", "KS"}, "east": {"2C", "3C"}}.Seats()
+
+	expected := Seats{{Name: "east", Cards: []string{"2C", "3C"}}, {Name: "west", Cards: []string{"AS", "KS"}}}
+	if !reflect.DeepEqual(seats, expected) {
+		t.Errorf("Expected seats %v, but got %v", expected, seats)
+	}
+}
+
+func TestEvaluateAndCompareSeats(t *testing.T) {
+	result, err := EvaluateAndCompareSeats(Seats{
+		{Name: "seat 3", Cards: []string{"2S", "3S", "4S", "5S", "7D"}},
+		{Name: "seat 1", Cards: []string{"AH", "AD", "KC", "QH", "9C"}},
+		{Name: "seat 2", Cards: []string{"2H", "3H", "4H", "5H", "7C"}},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	var seatNames []string
+	for _, handResult := range result.Seats {
+		seatNames = append(seatNames, handResult.HandName)
+	}
+	if expected := []string{"seat 3", "seat 1", "seat 2"}; !reflect.DeepEqual(seatNames, expected) {
+		t.Errorf("Expected seats %v, but got %v", expected, seatNames)
+	}
+
+	if expected := [][]string{{"seat 3", "seat 2"}}; !reflect.DeepEqual(result.Ties, expected) {
+		t.Errorf("Expected the tie in seat order %v, but got %v", expected, result.Ties)
+	}
+	if expected := []string{"seat 1"}; !reflect.DeepEqual(result.Winners, expected) {
+		t.Errorf("Expected winners %v, but got %v", expected, result.Winners)
+	}
+}
+
+func TestEvaluateGameSeats_DuplicateName(t *testing.T) {
+	_, err := EvaluateGameSeats(GameHoldem, []string{"AS", "KD", "7C"}, Seats{
+		{Name: "first", Cards: []string{"2C", "3C"}},
+		{Name: "first", Cards: []string{"4C", "5C"}},
+	})
+
+	var pokerError *pokererr.Error
+	if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeInvalidSeat {
+		t.Fatalf("Expected error code %s, but got %v", pokererr.CodeInvalidSeat, err)
+	}
+	if pokerError.Data["seat"] != 1 {
+		t.Errorf("Expected the second seat to be reported, but got %v", pokerError.Data["seat"])
+	}
+}
+
+func TestCalculateEquity_Seats(t *testing.T) {
+	result, err := CalculateEquity(context.Background(), EquityRequest{
+		Seats: Seats{
+			{Name: "second", Cards: []string{"QH", "JH"}},
+			{Name: "first", Cards: []string{"AH", "AD"}},
+		},
+		Board: []string{"AS", "KD", "7C", "2H"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if len(result.Seats) != 2 || result.Seats[0].HandName != "second" || result.Seats[0] != result.Hands["second"] {
+		t.Errorf("Expected equities in seat order, but got %v", result.Seats)
+	}
+}
@@ -0,0 +1,135 @@
+package holdem
+
+// The lowest rank of the Short Deck, deuces to fives are removed.
+const shortDeckLowestWeight CardWeight = 6
+
+// Short Deck combination weights, flush beats full house. Straight and three of a kind swap places
+// with the trips-beats-straight house rule.
+const (
+	shortDeckRoyalFlushCombinationWeight    = 10
+	shortDeckStraightFlushCombinationWeight = 9
+	shortDeckFourOfAKindCombinationWeight   = 8
+	shortDeckFlushCombinationWeight         = 7
+	shortDeckFullHouseCombinationWeight     = 6
+	shortDeckUpperCombinationWeight         = 5
+	shortDeckLowerCombinationWeight         = 4
+	shortDeckTwoPairCombinationWeight       = 3
+	shortDeckPairCombinationWeight          = 2
+	shortDeckHighCardCombinationWeight      = 1
+)
+
+type ShortDeckOptions struct {
+	// TripsBeatStraight is the house rule where three of a kind ranks above a straight.
+	TripsBeatStraight bool
+}
+
+// NewShortDeck returns 36 cards from sixes to aces ordered by suit and then by weight.
+func NewShortDeck() []Card {
+	deck := NewDeck()
+	shortDeck := deck[:0]
+	for _, card := range deck {
+		if card.Weight >= shortDeckLowestWeight {
+			shortDeck = append(shortDeck, card)
+		}
+	}
+
+	return shortDeck
+}
+
+// NewShortDeckEvaluator returns the Short Deck (6+) Hold'em evaluator: two hole cards, 3 to 5 board cards
+// and only cards from sixes to aces.
+func NewShortDeckEvaluator(options ShortDeckOptions) Evaluator {
+	return NewBestOfEvaluator(Rules{
+		HoleCards:  CardCount{Min: holdemHoleCardsCount, Max: holdemHoleCardsCount},
+		BoardCards: CardCount{Min: minBoardCardsCount, Max: maxBoardCardsCount},
+		Deck:       NewShortDeck(),
+	}, func(h *Hand) *HandResult {
+		return h.DefineShortDeckCombination(options)
+	})
+}
+
+// DefineShortDeckCombination Complexity: O(n log n) (linearithmic time)
+// Ranks five cards by the Short Deck rules: flush beats full house and A-6-7-8-9 is the lowest straight.
+// With TripsBeatStraight three of a kind also beats a straight.
+func (h *Hand) DefineShortDeckCombination(options ShortDeckOptions) *HandResult {
+	if len(h.Cards) != combinationCardsCount {
+		return nil
+	}
+
+	weights := make([]CardWeight, len(h.Cards))
+	flush := true
+	for i, card := range h.Cards {
+		weights[i] = card.ResolveWeight()
+		flush = flush && card.Suit == h.Cards[0].Suit
+	}
+
+	straightHigh := h.shortDeckStraightHighCard()
+	groupWeight, groupName := groupCombination(weights)
+
+	straightWeight, tripsWeight := int32(shortDeckUpperCombinationWeight), int32(shortDeckLowerCombinationWeight)
+	if options.TripsBeatStraight {
+		straightWeight, tripsWeight = tripsWeight, straightWeight
+	}
+
+	var (
+		combinationWeight int32
+		combinationName   string
+	)
+	switch {
+	case flush && straightHigh == cardWeights["A"]:
+		combinationWeight, combinationName = shortDeckRoyalFlushCombinationWeight, "Royal Flush"
+	case flush && straightHigh != 0:
+		combinationWeight, combinationName = shortDeckStraightFlushCombinationWeight, "Straight Flush"
+	case groupWeight == fourOfAKindCombinationWeight:
+		combinationWeight, combinationName = shortDeckFourOfAKindCombinationWeight, groupName
+	case flush:
+		combinationWeight, combinationName = shortDeckFlushCombinationWeight, "Flush"
+	case groupWeight == fullHouseCombinationWeight:
+		combinationWeight, combinationName = shortDeckFullHouseCombinationWeight, groupName
+	case straightHigh != 0:
+		combinationWeight, combinationName = straightWeight, "Straight"
+	case groupWeight == threeOfAKindCombinationWeight:
+		combinationWeight, combinationName = tripsWeight, groupName
+	case groupWeight == twoPairCombinationWeight:
+		combinationWeight, combinationName = shortDeckTwoPairCombinationWeight, groupName
+	case groupWeight == pairCombinationWeight:
+		combinationWeight, combinationName = shortDeckPairCombinationWeight, groupName
+	default:
+		combinationWeight, combinationName = shortDeckHighCardCombinationWeight, groupName
+	}
+
+	tieBreak := groupTieBreak(weights)
+	if straightHigh != 0 {
+		tieBreak = []CardWeight{straightHigh}
+	}
+
+	result := &HandResult{
+		HandName:          h.Name,
+		CombinationName:   combinationName,
+		HandWeight:        int32(h.calculateHandWeight()),
+		CombinationWeight: combinationWeight,
+		Rank:              newHandRank(combinationWeight, tieBreak),
+	}
+	result.explain(h.Cards, h.Cards, weights)
+
+	return result
+}
+
+// shortDeckStraightHighCard Complexity: O(n) (linear time)
+// Returns the top card weight of the highest straight of the Short Deck, or zero when there is no straight.
+// Ace also plays right below the six, so A-6-7-8-9 is a nine-high straight.
+func (h *Hand) shortDeckStraightHighCard() CardWeight {
+	if high := h.straightHighCard(); high > cardWeights["5"] {
+		return high
+	}
+
+	var present [15]bool
+	for _, card := range h.Cards {
+		present[card.ResolveWeight()] = true
+	}
+	if present[14] && present[6] && present[7] && present[8] && present[9] {
+		return cardWeights["9"]
+	}
+
+	return 0
+}
@@ -0,0 +1,113 @@
+package holdem
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+func TestHand_DefineShortDeckCombination(t *testing.T) {
+	tests := []struct {
+		name         string
+		options      ShortDeckOptions
+		winner       []string
+		loser        []string
+		expectedName string
+	}{
+		{
+			name:         "Flush beats full house",
+			winner:       []string{"6H", "8H", "9H", "JH", "KH"},
+			loser:        []string{"AS", "AC", "AD", "KS", "KC"},
+			expectedName: "Flush",
+		},
+		{
+			name:         "Ace plays below the six in a straight",
+			winner:       []string{"AH", "6C", "7D", "8S", "9H"},
+			loser:        []string{"AS", "KC", "QD", "JS", "9C"},
+			expectedName: "Straight",
+		},
+		{
+			name:         "Ace-low straight is the lowest straight",
+			winner:       []string{"6H", "7C", "8D", "9S", "TH"},
+			loser:        []string{"AS", "6C", "7D", "8S", "9C"},
+			expectedName: "Straight",
+		},
+		{
+			name:         "Ace-low straight flush",
+			winner:       []string{"AH", "6H", "7H", "8H", "9H"},
+			loser:        []string{"AS", "AC", "AD", "AH", "KC"},
+			expectedName: "Straight Flush",
+		},
+		{
+			name:         "Straight beats three of a kind",
+			winner:       []string{"6H", "7C", "8D", "9S", "TH"},
+			loser:        []string{"AS", "AC", "AD", "KS", "QC"},
+			expectedName: "Straight",
+		},
+		{
+			name:         "Three of a kind beats straight with the house rule",
+			options:      ShortDeckOptions{TripsBeatStraight: true},
+			winner:       []string{"6S", "6C", "6D", "KS", "QC"},
+			loser:        []string{"TH", "JC", "QD", "KH", "AH"},
+			expectedName: "Three of a kind",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			winner := handFromStrings(t, test.winner).DefineShortDeckCombination(test.options)
+			loser := handFromStrings(t, test.loser).DefineShortDeckCombination(test.options)
+
+			if winner.Compare(loser) <= 0 {
+				t.Errorf("Expected %v to beat %v", winner, loser)
+			}
+			if winner.CombinationName != test.expectedName {
+				t.Errorf("Expected combination name %s, but got %s", test.expectedName, winner.CombinationName)
+			}
+		})
+	}
+}
+
+func TestEvaluateGame_ShortDeck(t *testing.T) {
+	result, err := EvaluateGame(GameShortDeck, []string{"6H", "9H", "JH", "JC", "JD"}, Hands{
+		"first":  {"AH", "7H"},
+		"second": {"9S", "9C"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(result.Winners, []string{"first"}) {
+		t.Errorf("Expected the flush to beat the full house, but got %v", result.Winners)
+	}
+}
+
+func TestEvaluateGame_ShortDeckRejectsLowCards(t *testing.T) {
+	_, err := EvaluateGame(GameShortDeck, []string{"6H", "9H", "JH"}, Hands{
+		"first": {"AH", "5H"},
+	})
+
+	var pokerError *pokererr.Error
+	if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeInvalidCard {
+		t.Fatalf("Expected error code %s, but got %v", pokererr.CodeInvalidCard, err)
+	}
+
+	expected := []pokererr.Data{{"card": "5H", "reason": InvalidCardReasonNotInDeck, "location": "hands.first"}}
+	if !reflect.DeepEqual(pokerError.Data["cards"], expected) {
+		t.Errorf("Expected details %v, but got %v", expected, pokerError.Data["cards"])
+	}
+}
+
+func TestNewShortDeck(t *testing.T) {
+	deck := NewShortDeck()
+	if len(deck) != 36 {
+		t.Fatalf("Expected 36 cards, but got %d", len(deck))
+	}
+	for _, card := range deck {
+		if card.Weight < 6 {
+			t.Errorf("Unexpected card %s in the short deck", card)
+		}
+	}
+}
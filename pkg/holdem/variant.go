@@ -0,0 +1,327 @@
+package holdem
+
+import (
+	"sort"
+	"sync"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+type Game string
+
+const (
+	// GameFiveCard ranks five private cards, the same way as EvaluateAndCompareHands.
+	GameFiveCard     Game = "five-card"
+	GameHoldem       Game = "holdem"
+	GameOmaha        Game = "omaha"
+	GameOmaha5       Game = "omaha5"
+	GameOmahaHiLo    Game = "omaha-hi-lo"
+	GameStud         Game = "stud"
+	GameRazz         Game = "razz"
+	GameDeuceToSeven Game = "deuce-to-seven"
+	GameShortDeck    Game = "short-deck"
+	// GameShortDeckTrips is Short Deck with the house rule where three of a kind beats a straight.
+	GameShortDeckTrips Game = "short-deck-trips"
+	// GameFiveCardDeucesWild is five-card poker where every deuce plays as any card.
+	GameFiveCardDeucesWild Game = "five-card-deuces-wild"
+	// GameFiveCardJokers is five-card poker where jokers play as any card.
+	GameFiveCardJokers Game = "five-card-jokers"
+)
+
+// Evaluator ranks hands of one game variant. Results of the same evaluator are compared by Rank, the greater rank wins.
+type Evaluator interface {
+	// Rules returns the number of cards a hand and the board must have.
+	Rules() Rules
+	// Evaluate returns the best result of the hole cards together with the board, the board is empty in games
+	// without community cards. The cards are already validated against Rules.
+	Evaluate(handName string, hole, board []Card) *HandResult
+}
+
+// LowEvaluator is implemented by split pot games, where the pot is divided between the best Evaluate result
+// and the best EvaluateLow result.
+type LowEvaluator interface {
+	Evaluator
+	// EvaluateLow returns the best low of the hand, or nil when the hand has no qualifying low.
+	EvaluateLow(handName string, hole, board []Card) *HandResult
+}
+
+type Rules struct {
+	HoleCards  CardCount
+	BoardCards CardCount
+	// Deck is the deck of the game, nil means all 52 cards. Cards outside of the deck are rejected.
+	Deck []Card
+	// Jokers allows any number of jokers written as "JK" in hands and on the board.
+	Jokers bool
+}
+
+// CardCount is the inclusive range of the allowed number of cards.
+type CardCount struct {
+	Min int
+	Max int
+}
+
+func (c CardCount) contains(count int) bool {
+	return count >= c.Min && count <= c.Max
+}
+
+// GameResult is EvaluateResult of any game. Shares are the parts of the pot every winning hand takes, from 0 to 1,
+// and Low is the separate low ranking of split pot games.
+type GameResult struct {
+	Game Game `json:"game"`
+	*EvaluateResult
+	Low    *EvaluateResult    `json:"low,omitempty"`
+	Shares map[string]float64 `json:"shares"`
+}
+
+var (
+	evaluatorsMu sync.RWMutex
+	evaluators   = map[Game]Evaluator{
+		GameFiveCard: NewBestOfEvaluator(Rules{HoleCards: CardCount{Min: 5, Max: 5}}, (*Hand).DefineCombination),
+		GameHoldem: NewBestOfEvaluator(Rules{
+			HoleCards:  CardCount{Min: holdemHoleCardsCount, Max: holdemHoleCardsCount},
+			BoardCards: CardCount{Min: minBoardCardsCount, Max: maxBoardCardsCount},
+		}, (*Hand).DefineCombination),
+		GameOmaha: NewOmahaEvaluator(Rules{
+			HoleCards:  CardCount{Min: OmahaHoleCardsCount, Max: OmahaHoleCardsCount},
+			BoardCards: CardCount{Min: minBoardCardsCount, Max: maxBoardCardsCount},
+		}, (*Hand).DefineCombination),
+		GameOmaha5: NewOmahaEvaluator(Rules{
+			HoleCards:  CardCount{Min: Omaha5HoleCardsCount, Max: Omaha5HoleCardsCount},
+			BoardCards: CardCount{Min: minBoardCardsCount, Max: maxBoardCardsCount},
+		}, (*Hand).DefineCombination),
+		GameOmahaHiLo: omahaHiLoEvaluator{NewOmahaEvaluator(Rules{
+			HoleCards:  CardCount{Min: OmahaHoleCardsCount, Max: Omaha5HoleCardsCount},
+			BoardCards: CardCount{Min: minBoardCardsCount, Max: maxBoardCardsCount},
+		}, (*Hand).DefineCombination)},
+		GameStud:           NewBestOfEvaluator(Rules{HoleCards: CardCount{Min: 5, Max: 7}}, (*Hand).DefineCombination),
+		GameRazz:           NewBestOfEvaluator(Rules{HoleCards: CardCount{Min: 5, Max: 7}}, (*Hand).DefineAceToFiveLow),
+		GameDeuceToSeven:   NewBestOfEvaluator(Rules{HoleCards: CardCount{Min: 5, Max: 5}}, (*Hand).DefineDeuceToSevenLow),
+		GameShortDeck:      NewShortDeckEvaluator(ShortDeckOptions{}),
+		GameShortDeckTrips: NewShortDeckEvaluator(ShortDeckOptions{TripsBeatStraight: true}),
+		GameFiveCardDeucesWild: NewBestOfEvaluator(Rules{HoleCards: CardCount{Min: 5, Max: 5}}, func(h *Hand) *HandResult {
+			return h.DefineWildCombination(WildOptions{WildRanks: []CardName{"2"}})
+		}),
+		GameFiveCardJokers: NewBestOfEvaluator(Rules{HoleCards: CardCount{Min: 5, Max: 5}, Jokers: true}, func(h *Hand) *HandResult {
+			return h.DefineWildCombination(WildOptions{})
+		}),
+	}
+)
+
+// RegisterEvaluator adds a custom game variant. Built-in and already registered games can't be replaced.
+func RegisterEvaluator(game Game, evaluator Evaluator) error {
+	evaluatorsMu.Lock()
+	defer evaluatorsMu.Unlock()
+
+	if _, ok := evaluators[game]; ok {
+		return pokererr.NewError(pokererr.CodeGameAlreadyRegistered, pokererr.Data{"game": game})
+	}
+	evaluators[game] = evaluator
+
+	return nil
+}
+
+// LookupEvaluator returns the evaluator of the game or a CodeUnsupportedGame error.
+func LookupEvaluator(game Game) (Evaluator, error) {
+	evaluatorsMu.RLock()
+	defer evaluatorsMu.RUnlock()
+
+	evaluator, ok := evaluators[game]
+	if !ok {
+		return nil, pokererr.NewError(pokererr.CodeUnsupportedGame, pokererr.Data{"game": game})
+	}
+
+	return evaluator, nil
+}
+
+// Games returns all registered games in alphabetical order.
+func Games() []Game {
+	evaluatorsMu.RLock()
+	defer evaluatorsMu.RUnlock()
+
+	games := make([]Game, 0, len(evaluators))
+	for game := range evaluators {
+		games = append(games, game)
+	}
+	sort.Slice(games, func(i, j int) bool {
+		return games[i] < games[j]
+	})
+
+	return games
+}
+
+// DefaultGame is the game of deals that don't name one: holdem for deals with a board and five-card without it.
+func DefaultGame(board []string) Game {
+	if len(board) > 0 {
+		return GameHoldem
+	}
+
+	return GameFiveCard
+}
+
+// EvaluateGame evaluates and compares hands by the rules of the registered game.
+func EvaluateGame(game Game, board []string, hands Hands) (*GameResult, error) {
+	return EvaluateGameSeats(game, board, hands.Seats())
+}
+
+// EvaluateGameSeats is EvaluateGame for hands in seat order.
+func EvaluateGameSeats(game Game, board []string, seats Seats) (*GameResult, error) {
+	evaluator, err := LookupEvaluator(game)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateRules(evaluator.Rules(), board, seats); err != nil {
+		return nil, err
+	}
+
+	parser := newDealParser()
+	parser.allowJokers = evaluator.Rules().Jokers
+	boardCards := parser.parse(boardLocation, board)
+	handsWithCards := parser.parseSeats(seats)
+	if err := parser.err(); err != nil {
+		return nil, err
+	}
+
+	if err := validateDeck(evaluator.Rules().Deck, boardCards, handsWithCards); err != nil {
+		return nil, err
+	}
+
+	highCombinations := make([]*HandResult, 0, len(handsWithCards))
+	for _, hand := range handsWithCards {
+		highCombinations = append(highCombinations, evaluator.Evaluate(hand.Name, hand.Cards, boardCards))
+	}
+
+	result := &GameResult{
+		Game:           game,
+		EvaluateResult: newEvaluateResult(highCombinations),
+	}
+
+	var lowWinners []string
+	if lowEvaluator, ok := evaluator.(LowEvaluator); ok {
+		lowCombinations := make([]*HandResult, 0, len(handsWithCards))
+		for _, hand := range handsWithCards {
+			lowCombinations = append(lowCombinations, lowEvaluator.EvaluateLow(hand.Name, hand.Cards, boardCards))
+		}
+
+		result.Low = newEvaluateResult(lowCombinations)
+		lowWinners = result.Low.Winners
+	}
+
+	result.Shares = splitShares(result.Winners, lowWinners)
+
+	return result, nil
+}
+
+func validateRules(rules Rules, board []string, seats Seats) error {
+	if !rules.BoardCards.contains(len(board)) {
+		return pokererr.NewError(pokererr.CodeInvalidBoardSize, pokererr.Data{
+			"count": len(board),
+			"min":   rules.BoardCards.Min,
+			"max":   rules.BoardCards.Max,
+		})
+	}
+
+	for _, seat := range seats {
+		if !rules.HoleCards.contains(len(seat.Cards)) {
+			return pokererr.NewError(pokererr.CodeInvalidHoleCardCount, pokererr.Data{
+				"hand":  seat.Name,
+				"count": len(seat.Cards),
+				"min":   rules.HoleCards.Min,
+				"max":   rules.HoleCards.Max,
+			})
+		}
+	}
+
+	return nil
+}
+
+// validateDeck rejects cards that are not in the deck of the game with a CodeInvalidCard error.
+func validateDeck(deck []Card, board []Card, hands []Hand) error {
+	if deck == nil {
+		return nil
+	}
+
+	inDeck := make(map[Card]bool, len(deck))
+	for _, card := range deck {
+		inDeck[card] = true
+	}
+
+	var invalid []pokererr.Data
+	check := func(location string, cards []Card) {
+		for _, card := range cards {
+			if !inDeck[card] {
+				invalid = append(invalid, pokererr.Data{
+					"card":     card.String(),
+					"reason":   InvalidCardReasonNotInDeck,
+					"location": location,
+				})
+			}
+		}
+	}
+
+	check(boardLocation, board)
+	for _, hand := range hands {
+		check(handLocation(hand.Name), hand.Cards)
+	}
+
+	if len(invalid) > 0 {
+		return pokererr.NewError(pokererr.CodeInvalidCard, pokererr.Data{"cards": invalid})
+	}
+
+	return nil
+}
+
+type bestOfEvaluator struct {
+	rules  Rules
+	define func(h *Hand) *HandResult
+}
+
+// NewBestOfEvaluator returns an evaluator that ranks the best five cards out of the hole cards and the board
+// by the given rules, e.g. (*Hand).DefineCombination for high hands or (*Hand).DefineAceToFiveLow for Razz.
+func NewBestOfEvaluator(rules Rules, define func(h *Hand) *HandResult) Evaluator {
+	return bestOfEvaluator{rules: rules, define: define}
+}
+
+func (e bestOfEvaluator) Rules() Rules {
+	return e.rules
+}
+
+func (e bestOfEvaluator) Evaluate(handName string, hole, board []Card) *HandResult {
+	hand := Hand{
+		Name:  handName,
+		Cards: append(append(make([]Card, 0, len(hole)+len(board)), hole...), board...),
+	}
+
+	return hand.bestOf(e.define)
+}
+
+type omahaEvaluator struct {
+	rules  Rules
+	define func(h *Hand) *HandResult
+}
+
+// NewOmahaEvaluator returns an evaluator that ranks the best five cards made of exactly two hole cards
+// and exactly three board cards by the given rules.
+func NewOmahaEvaluator(rules Rules, define func(h *Hand) *HandResult) Evaluator {
+	return omahaEvaluator{rules: rules, define: define}
+}
+
+func (e omahaEvaluator) Rules() Rules {
+	return e.rules
+}
+
+func (e omahaEvaluator) Evaluate(handName string, hole, board []Card) *HandResult {
+	hand := Hand{Name: handName, Cards: hole}
+
+	return hand.bestOmahaOf(board, e.define)
+}
+
+type omahaHiLoEvaluator struct {
+	Evaluator
+}
+
+func (e omahaHiLoEvaluator) EvaluateLow(handName string, hole, board []Card) *HandResult {
+	hand := Hand{Name: handName, Cards: hole}
+
+	return hand.bestOmahaOf(board, (*Hand).DefineEightOrBetterLow)
+}
@@ -0,0 +1,192 @@
+package holdem
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+func TestEvaluateGame(t *testing.T) {
+	tests := []struct {
+		name            string
+		game            Game
+		board           []string
+		hands           Hands
+		expectedWinners []string
+		expectedShares  map[string]float64
+	}{
+		{
+			name: "Five-card",
+			game: GameFiveCard,
+			hands: Hands{
+				"first":  {"7H", "8H", "9H", "TH", "JH"},
+				"second": {"TS", "JS", "QS", "KS", "AS"},
+			},
+			expectedWinners: []string{"second"},
+			expectedShares:  map[string]float64{"second": 1},
+		},
+		{
+			name:  "Hold'em split",
+			game:  GameHoldem,
+			board: []string{"TS", "JS", "QS", "KS", "AS"},
+			hands: Hands{
+				"first":  {"2H", "3C"},
+				"second": {"9D", "8D"},
+			},
+			expectedWinners: []string{"first", "second"},
+			expectedShares:  map[string]float64{"first": 0.5, "second": 0.5},
+		},
+		{
+			name:  "Omaha",
+			game:  GameOmaha,
+			board: []string{"2H", "5H", "9H", "JH", "KC"},
+			hands: Hands{
+				"first":  {"AH", "AC", "AD", "7S"},
+				"second": {"TH", "8H", "3C", "4D"},
+			},
+			expectedWinners: []string{"second"},
+			expectedShares:  map[string]float64{"second": 1},
+		},
+		{
+			name:  "Omaha Hi-Lo quartered",
+			game:  GameOmahaHiLo,
+			board: []string{"2H", "5D", "8C", "KS", "QD"},
+			hands: Hands{
+				"first":  {"AS", "3S", "KC", "KH"},
+				"second": {"AD", "3C", "JC", "TH"},
+			},
+			expectedWinners: []string{"first"},
+			expectedShares:  map[string]float64{"first": 0.75, "second": 0.25},
+		},
+		{
+			name: "Stud",
+			game: GameStud,
+			hands: Hands{
+				"first":  {"AH", "AC", "2D", "5S", "7H", "KC", "KD"},
+				"second": {"3H", "4C", "6D", "7S", "8C", "9D", "TC"},
+			},
+			expectedWinners: []string{"second"},
+			expectedShares:  map[string]float64{"second": 1},
+		},
+		{
+			name: "Razz",
+			game: GameRazz,
+			hands: Hands{
+				"first":  {"AH", "2C", "3D", "5S", "7H", "KC", "KD"},
+				"second": {"3H", "4C", "6D", "7S", "8C", "9D", "TC"},
+			},
+			expectedWinners: []string{"first"},
+			expectedShares:  map[string]float64{"first": 1},
+		},
+		{
+			name: "Deuce-to-seven",
+			game: GameDeuceToSeven,
+			hands: Hands{
+				"first":  {"7H", "5C", "4D", "3S", "2H"},
+				"second": {"AC", "2D", "3C", "4C", "5D"},
+			},
+			expectedWinners: []string{"first"},
+			expectedShares:  map[string]float64{"first": 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := EvaluateGame(test.game, test.board, test.hands)
+			if err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+
+			if result.Game != test.game {
+				t.Errorf("Expected game %s, but got %s", test.game, result.Game)
+			}
+			if !reflect.DeepEqual(result.Winners, test.expectedWinners) {
+				t.Errorf("Expected winners %v, but got %v", test.expectedWinners, result.Winners)
+			}
+			if !reflect.DeepEqual(result.Shares, test.expectedShares) {
+				t.Errorf("Expected shares %v, but got %v", test.expectedShares, result.Shares)
+			}
+		})
+	}
+}
+
+func TestEvaluateGame_Errors(t *testing.T) {
+	tests := []struct {
+		name         string
+		game         Game
+		board        []string
+		hands        Hands
+		expectedCode pokererr.Code
+	}{
+		{
+			name:         "Unsupported game",
+			game:         "badugi",
+			hands:        Hands{"first": {"AH", "2C", "3D", "4S"}},
+			expectedCode: pokererr.CodeUnsupportedGame,
+		},
+		{
+			name:         "Board in a game without board",
+			game:         GameStud,
+			board:        []string{"2C", "3C", "4C"},
+			hands:        Hands{"first": {"AH", "2H", "3D", "4S", "5S"}},
+			expectedCode: pokererr.CodeInvalidBoardSize,
+		},
+		{
+			name:         "Too many hole cards",
+			game:         GameHoldem,
+			board:        []string{"2C", "3C", "4C"},
+			hands:        Hands{"first": {"AH", "2H", "3D"}},
+			expectedCode: pokererr.CodeInvalidHoleCardCount,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := EvaluateGame(test.game, test.board, test.hands)
+
+			var pokerError *pokererr.Error
+			if !errors.As(err, &pokerError) || pokerError.Code != test.expectedCode {
+				t.Errorf("Expected error code %s, but got %v", test.expectedCode, err)
+			}
+		})
+	}
+}
+
+func TestRegisterEvaluator(t *testing.T) {
+	pineapple := Game("test-pineapple")
+	evaluator := NewBestOfEvaluator(Rules{
+		HoleCards:  CardCount{Min: 3, Max: 3},
+		BoardCards: CardCount{Min: 5, Max: 5},
+	}, (*Hand).DefineCombination)
+
+	if err := RegisterEvaluator(pineapple, evaluator); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	var pokerError *pokererr.Error
+	if err := RegisterEvaluator(pineapple, evaluator); !errors.As(err, &pokerError) ||
+		pokerError.Code != pokererr.CodeGameAlreadyRegistered {
+		t.Errorf("Expected error code %s, but got %v", pokererr.CodeGameAlreadyRegistered, err)
+	}
+
+	result, err := EvaluateGame(pineapple, []string{"2C", "7D", "9S", "JH", "3D"}, Hands{
+		"first":  {"AH", "AC", "AD"},
+		"second": {"KH", "KC", "4S"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(result.Winners, []string{"first"}) {
+		t.Errorf("Expected the first hand to win, but got %v", result.Winners)
+	}
+
+	found := false
+	for _, game := range Games() {
+		found = found || game == pineapple
+	}
+	if !found {
+		t.Errorf("Expected %s among registered games", pineapple)
+	}
+}
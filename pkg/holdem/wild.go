@@ -0,0 +1,123 @@
+package holdem
+
+const (
+	fiveOfAKindCombinationWeight = 11
+
+	jokerName CardName = "JK"
+)
+
+// Joker is the card that plays as any card. It's written as "JK" and accepted only by games that allow jokers.
+var Joker = Card{Name: jokerName}
+
+type WildOptions struct {
+	// WildRanks are ranks that play as any card, e.g. "2" for deuces wild. Jokers are always wild.
+	WildRanks []CardName
+}
+
+// WildCard tells what a wild card of the hand played as.
+type WildCard struct {
+	Card    string `json:"card"`
+	PlaysAs string `json:"playsAs"`
+}
+
+func (o WildOptions) isWild(card Card) bool {
+	if card.Name == jokerName {
+		return true
+	}
+	for _, name := range o.WildRanks {
+		if card.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DefineWildCombination Complexity: O(C(12 + w, w)) (binomial)
+// Ranks five cards where jokers and cards of the wild ranks play as any card. Every multiset of ranks for the wild cards
+// is tried. The wild cards take the suit of the first natural card, since no other suit can make a flush, and may repeat
+// natural cards, so five of a kind becomes the highest combination. Four wild cards take 1820 attempts.
+// What every wild card played as is reported in WildCards.
+func (h *Hand) DefineWildCombination(options WildOptions) *HandResult {
+	if len(h.Cards) != combinationCardsCount {
+		return nil
+	}
+
+	var natural, wild []Card
+	for _, card := range h.Cards {
+		if options.isWild(card) {
+			wild = append(wild, card)
+		} else {
+			natural = append(natural, card)
+		}
+	}
+
+	if len(wild) == 0 {
+		return h.defineWithFiveOfAKind()
+	}
+
+	suit := cardSuitsList[0]
+	if len(natural) > 0 {
+		suit = natural[0].Suit
+	}
+
+	var (
+		best       *HandResult
+		bestPlayed Hand
+		ranks      = make([]int, len(wild))
+	)
+
+	var walk func(i, from int)
+	walk = func(i, from int) {
+		if i == len(wild) {
+			candidate := Hand{Name: h.Name, Cards: append(make([]Card, 0, combinationCardsCount), natural...)}
+			for _, rank := range ranks {
+				candidate.Cards = append(candidate.Cards, newCard(string(cardsList[rank])+string(suit)))
+			}
+
+			result := candidate.defineWithFiveOfAKind()
+			if result != nil && (best == nil || result.Rank > best.Rank) {
+				best, bestPlayed = result, candidate
+			}
+			return
+		}
+
+		for rank := from; rank < len(cardsList); rank++ {
+			ranks[i] = rank
+			walk(i+1, rank)
+		}
+	}
+	walk(0, 0)
+
+	best.HandWeight = int32(h.calculateHandWeight())
+	best.explain(append(natural, wild...), bestPlayed.Cards, bestPlayed.resolveWeights())
+	for i, card := range wild {
+		best.WildCards = append(best.WildCards, WildCard{
+			Card:    card.String(),
+			PlaysAs: bestPlayed.Cards[len(natural)+i].String(),
+		})
+	}
+
+	return best
+}
+
+// defineWithFiveOfAKind ranks five cards that may repeat, five cards of one rank beat any other combination.
+func (h *Hand) defineWithFiveOfAKind() *HandResult {
+	weight := h.Cards[0].ResolveWeight()
+	for _, card := range h.Cards {
+		if card.ResolveWeight() != weight {
+			return h.DefineCombination()
+		}
+	}
+
+	result := &HandResult{
+		HandName:          h.Name,
+		CombinationName:   "Five of a kind",
+		HandWeight:        int32(h.calculateHandWeight()),
+		CombinationWeight: fiveOfAKindCombinationWeight,
+		Rank:              newHandRank(fiveOfAKindCombinationWeight, []CardWeight{weight}),
+	}
+	result.explain(h.Cards, h.Cards, h.resolveWeights())
+
+	return result
+}
@@ -0,0 +1,101 @@
+package holdem
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	pokererr "github.com/devandreyl/go-poker-hands-evaluator/pkg/error"
+)
+
+func TestHand_DefineWildCombination(t *testing.T) {
+	deucesWild := WildOptions{WildRanks: []CardName{"2"}}
+
+	tests := []struct {
+		name              string
+		cards             []Card
+		options           WildOptions
+		expectedName      string
+		expectedWildCards []WildCard
+	}{
+		{
+			name:              "Joker makes five of a kind",
+			cards:             []Card{cardOf("AS"), cardOf("AH"), cardOf("AD"), cardOf("AC"), Joker},
+			expectedName:      "Five of a kind",
+			expectedWildCards: []WildCard{{Card: "JK", PlaysAs: "AS"}},
+		},
+		{
+			name:              "Deuce completes a royal flush",
+			cards:             []Card{cardOf("AH"), cardOf("KH"), cardOf("QH"), cardOf("JH"), cardOf("2C")},
+			options:           deucesWild,
+			expectedName:      "Royal Flush",
+			expectedWildCards: []WildCard{{Card: "2C", PlaysAs: "TH"}},
+		},
+		{
+			name:              "Deuces make four of a kind rather than a straight",
+			cards:             []Card{cardOf("9S"), cardOf("9H"), cardOf("TD"), cardOf("2C"), cardOf("2D")},
+			options:           deucesWild,
+			expectedName:      "Four of a kind",
+			expectedWildCards: []WildCard{{Card: "2C", PlaysAs: "9S"}, {Card: "2D", PlaysAs: "9S"}},
+		},
+		{
+			name:              "Joker fills an inside straight",
+			cards:             []Card{cardOf("5S"), cardOf("6H"), cardOf("8D"), cardOf("9C"), Joker},
+			expectedName:      "Straight",
+			expectedWildCards: []WildCard{{Card: "JK", PlaysAs: "7S"}},
+		},
+		{
+			name:         "Without wild cards the hand is ranked as usual",
+			cards:        []Card{cardOf("5S"), cardOf("6H"), cardOf("8D"), cardOf("9C"), cardOf("2D")},
+			expectedName: "High card",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := (&Hand{Name: "Test Hand", Cards: test.cards}).DefineWildCombination(test.options)
+
+			if result.CombinationName != test.expectedName {
+				t.Errorf("Expected combination name %s, but got %s", test.expectedName, result.CombinationName)
+			}
+			if !reflect.DeepEqual(result.WildCards, test.expectedWildCards) {
+				t.Errorf("Expected wild cards %v, but got %v", test.expectedWildCards, result.WildCards)
+			}
+		})
+	}
+}
+
+func TestEvaluateGame_Jokers(t *testing.T) {
+	result, err := EvaluateGame(GameFiveCardJokers, nil, Hands{
+		"first":  {"KS", "KH", "KD", "JK", "JK"},
+		"second": {"TS", "JS", "QS", "KC", "AS"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(result.Winners, []string{"first"}) {
+		t.Errorf("Expected five of a kind to win, but got %v", result.Winners)
+	}
+	if name := result.Result["first"].CombinationName; name != "Five of a kind" {
+		t.Errorf("Expected five of a kind, but got %s", name)
+	}
+}
+
+func TestEvaluateGame_JokersNotAllowed(t *testing.T) {
+	_, err := EvaluateGame(GameFiveCard, nil, Hands{"first": {"KS", "KH", "KD", "JK", "2C"}})
+
+	var pokerError *pokererr.Error
+	if !errors.As(err, &pokerError) || pokerError.Code != pokererr.CodeInvalidCard {
+		t.Errorf("Expected error code %s, but got %v", pokererr.CodeInvalidCard, err)
+	}
+}
+
+func cardOf(cardString string) Card {
+	card, err := ParseCard(cardString)
+	if err != nil {
+		panic(err)
+	}
+
+	return card
+}
@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: poker/v1/poker.proto
+
+package pokerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Evaluator_Evaluate_FullMethodName     = "/poker.v1.Evaluator/Evaluate"
+	Evaluator_Compare_FullMethodName      = "/poker.v1.Evaluator/Compare"
+	Evaluator_CompareBatch_FullMethodName = "/poker.v1.Evaluator/CompareBatch"
+)
+
+// EvaluatorClient is the client API for Evaluator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Evaluator evaluates and compares poker hands with the same rules as the HTTP API.
+// Invalid deals fail with INVALID_ARGUMENT, the status details contain the Error with the error code and its data.
+type EvaluatorClient interface {
+	// Evaluate ranks one hand, the board is shared by games like holdem and omaha.
+	Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error)
+	// Compare evaluates and compares all hands of a deal.
+	Compare(ctx context.Context, in *CompareRequest, opts ...grpc.CallOption) (*CompareResponse, error)
+	// CompareBatch compares a stream of deals. Results are streamed back in the order of the deals while the next ones
+	// are received, an invalid deal is reported in its own response and doesn't end the stream.
+	CompareBatch(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[CompareBatchRequest, CompareBatchResponse], error)
+}
+
+type evaluatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEvaluatorClient(cc grpc.ClientConnInterface) EvaluatorClient {
+	return &evaluatorClient{cc}
+}
+
+func (c *evaluatorClient) Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluateResponse)
+	err := c.cc.Invoke(ctx, Evaluator_Evaluate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluatorClient) Compare(ctx context.Context, in *CompareRequest, opts ...grpc.CallOption) (*CompareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareResponse)
+	err := c.cc.Invoke(ctx, Evaluator_Compare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluatorClient) CompareBatch(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[CompareBatchRequest, CompareBatchResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Evaluator_ServiceDesc.Streams[0], Evaluator_CompareBatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CompareBatchRequest, CompareBatchResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Evaluator_CompareBatchClient = grpc.BidiStreamingClient[CompareBatchRequest, CompareBatchResponse]
+
+// EvaluatorServer is the server API for Evaluator service.
+// All implementations must embed UnimplementedEvaluatorServer
+// for forward compatibility.
+//
+// Evaluator evaluates and compares poker hands with the same rules as the HTTP API.
+// Invalid deals fail with INVALID_ARGUMENT, the status details contain the Error with the error code and its data.
+type EvaluatorServer interface {
+	// Evaluate ranks one hand, the board is shared by games like holdem and omaha.
+	Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error)
+	// Compare evaluates and compares all hands of a deal.
+	Compare(context.Context, *CompareRequest) (*CompareResponse, error)
+	// CompareBatch compares a stream of deals. Results are streamed back in the order of the deals while the next ones
+	// are received, an invalid deal is reported in its own response and doesn't end the stream.
+	CompareBatch(grpc.BidiStreamingServer[CompareBatchRequest, CompareBatchResponse]) error
+	mustEmbedUnimplementedEvaluatorServer()
+}
+
+// UnimplementedEvaluatorServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEvaluatorServer struct{}
+
+func (UnimplementedEvaluatorServer) Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evaluate not implemented")
+}
+func (UnimplementedEvaluatorServer) Compare(context.Context, *CompareRequest) (*CompareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Compare not implemented")
+}
+func (UnimplementedEvaluatorServer) CompareBatch(grpc.BidiStreamingServer[CompareBatchRequest, CompareBatchResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method CompareBatch not implemented")
+}
+func (UnimplementedEvaluatorServer) mustEmbedUnimplementedEvaluatorServer() {}
+func (UnimplementedEvaluatorServer) testEmbeddedByValue()                   {}
+
+// UnsafeEvaluatorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EvaluatorServer will
+// result in compilation errors.
+type UnsafeEvaluatorServer interface {
+	mustEmbedUnimplementedEvaluatorServer()
+}
+
+func RegisterEvaluatorServer(s grpc.ServiceRegistrar, srv EvaluatorServer) {
+	// If the following call pancis, it indicates UnimplementedEvaluatorServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Evaluator_ServiceDesc, srv)
+}
+
+func _Evaluator_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluatorServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Evaluator_Evaluate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluatorServer).Evaluate(ctx, req.(*EvaluateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Evaluator_Compare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluatorServer).Compare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Evaluator_Compare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluatorServer).Compare(ctx, req.(*CompareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Evaluator_CompareBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EvaluatorServer).CompareBatch(&grpc.GenericServerStream[CompareBatchRequest, CompareBatchResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Evaluator_CompareBatchServer = grpc.BidiStreamingServer[CompareBatchRequest, CompareBatchResponse]
+
+// Evaluator_ServiceDesc is the grpc.ServiceDesc for Evaluator service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Evaluator_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "poker.v1.Evaluator",
+	HandlerType: (*EvaluatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Evaluate",
+			Handler:    _Evaluator_Evaluate_Handler,
+		},
+		{
+			MethodName: "Compare",
+			Handler:    _Evaluator_Compare_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CompareBatch",
+			Handler:       _Evaluator_CompareBatch_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "poker/v1/poker.proto",
+}